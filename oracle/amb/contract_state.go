@@ -0,0 +1,60 @@
+package amb
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"oracle/contract"
+)
+
+// ReadContractState reads the TrustlessAMB state for the message execution at the latest block
+func ReadContractState(ctx context.Context, client *ethclient.Client, address common.Address, sourceSlot uint64, msgHash common.Hash) (*ContractState, error) {
+	latest, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("can't get latest block: %w", err)
+	}
+	state := &ContractState{}
+
+	call := func(result interface{}, contractABI *abi.ABI, to common.Address, method string, args ...interface{}) error {
+		data, err := contractABI.Pack(method, args...)
+		if err != nil {
+			return err
+		}
+		res, err := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, latest.Number)
+		if err != nil {
+			return fmt.Errorf("can't call %s: %w", method, err)
+		}
+		if err = contractABI.UnpackIntoInterface(result, method, res); err != nil {
+			return fmt.Errorf("can't unpack %s: %w", method, err)
+		}
+		return nil
+	}
+
+	slot := new(big.Int).SetUint64(sourceSlot)
+	if err = call(&state.OtherSideAMB, &contract.AMBABI, address, "otherSideAMB"); err != nil {
+		return nil, err
+	}
+	if err = call(&state.StorageRoot, &contract.AMBABI, address, "storageRoot", slot); err != nil {
+		return nil, err
+	}
+	var status uint8
+	if err = call(&status, &contract.AMBABI, address, "executionStatus", msgHash); err != nil {
+		return nil, err
+	}
+	// ExecutionStatus.NOT_EXECUTED is 0
+	state.Executed = status != 0
+	var lightClient common.Address
+	if err = call(&lightClient, &contract.AMBABI, address, "lightClient"); err != nil {
+		return nil, err
+	}
+	if err = call(&state.StateRoot, &contract.BeaconLightClientABI, lightClient, "stateRoot", slot); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
@@ -0,0 +1,149 @@
+package amb
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// rlpItem mirrors RLPReader.RLPItem, raw holds the whole encoded item including its prefix
+type rlpItem []byte
+
+func (item rlpItem) isList() bool {
+	return len(item) > 0 && item[0] >= 0xc0
+}
+
+// toList mirrors RLPReader.toList
+func (item rlpItem) toList() ([]rlpItem, error) {
+	if !item.isList() {
+		return nil, fmt.Errorf("item is not a list")
+	}
+	content, _, err := rlp.SplitList(item)
+	if err != nil {
+		return nil, err
+	}
+	var res []rlpItem
+	for len(content) > 0 {
+		_, _, rest, err := rlp.Split(content)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rlpItem(content[:len(content)-len(rest)]))
+		content = rest
+	}
+	return res, nil
+}
+
+// payload returns the item content without the prefix
+func (item rlpItem) payload() ([]byte, error) {
+	if len(item) == 0 {
+		return nil, fmt.Errorf("empty item")
+	}
+	_, content, _, err := rlp.Split(item)
+	return content, err
+}
+
+// toUint mirrors RLPReader.toUint, the result is converted to bytes32 as the contract does
+func (item rlpItem) toUint() (common.Hash, error) {
+	if len(item) == 0 || len(item) > 33 {
+		return common.Hash{}, fmt.Errorf("invalid uint item length %d", len(item))
+	}
+	content, err := item.payload()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(content), nil
+}
+
+// toUintStrict mirrors RLPReader.toUintStrict, that only accepts 32 bytes strings
+func (item rlpItem) toUintStrict() (common.Hash, error) {
+	if len(item) != 33 {
+		return common.Hash{}, fmt.Errorf("invalid uint item length %d", len(item))
+	}
+	return item.toUint()
+}
+
+// toAddress mirrors RLPReader.toAddress
+func (item rlpItem) toAddress() (common.Address, error) {
+	if len(item) != 21 {
+		return common.Address{}, fmt.Errorf("invalid address item length %d", len(item))
+	}
+	content, err := item.payload()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(content), nil
+}
+
+// toBytes mirrors RLPReader.toBytes
+func (item rlpItem) toBytes() ([]byte, error) {
+	return item.payload()
+}
+
+// nibble mirrors MPT._nibble, nibbles after the end of the key are zeros
+func nibble(key common.Hash, index int) int {
+	if index > 63 {
+		return 0
+	}
+	b := key[index/2]
+	if index%2 == 0 {
+		return int(b >> 4)
+	}
+	return int(b & 0xf)
+}
+
+// readProof mirrors MPT.readProof. As the contract, it doesn't check extension and leaf paths against the key,
+// only branch nodes are walked according to the key nibbles.
+func readProof(key common.Hash, proof [][]byte) ([]byte, error) {
+	if len(proof) == 0 {
+		return nil, &ProofError{Reason: ContractPanicError, Details: "empty proof"}
+	}
+	node := proof[0]
+	currentPathLength := 0
+	for i := 0; ; {
+		ls, err := rlpItem(node).toList()
+		if err != nil {
+			return nil, &ProofError{Reason: DecodeError, Details: fmt.Sprintf("node %d: %s", i, err)}
+		}
+		var root common.Hash
+		if len(ls) == 17 {
+			root, err = ls[nibble(key, currentPathLength)].toUint()
+			currentPathLength++
+			if err != nil {
+				return nil, &ProofError{Reason: DecodeError, Details: fmt.Sprintf("branch node %d: %s", i, err)}
+			}
+		} else {
+			if len(ls) != 2 {
+				return nil, &ProofError{Reason: MPTListLengthError, Details: fmt.Sprintf("node %d has %d items", i, len(ls))}
+			}
+			path, err := ls[0].toBytes()
+			if err != nil || len(path) == 0 {
+				return nil, &ProofError{Reason: DecodeError, Details: fmt.Sprintf("node %d has invalid path", i)}
+			}
+			prefix := int(path[0]) / 16
+			currentPathLength += (len(path)-1)*2 + prefix%2
+			if prefix > 1 {
+				value, err := ls[1].toBytes()
+				if err != nil {
+					return nil, &ProofError{Reason: DecodeError, Details: fmt.Sprintf("leaf node %d: %s", i, err)}
+				}
+				return value, nil
+			}
+			root, err = ls[1].toUint()
+			if err != nil {
+				return nil, &ProofError{Reason: DecodeError, Details: fmt.Sprintf("extension node %d: %s", i, err)}
+			}
+		}
+
+		i++
+		if i >= len(proof) {
+			return nil, &ProofError{Reason: ContractPanicError, Details: fmt.Sprintf("proof ends after %d nodes, %d key nibbles are walked", i, currentPathLength)}
+		}
+		node = proof[i]
+		if gethcrypto.Keccak256Hash(node) != root {
+			return nil, &ProofError{Reason: MPTNodeHashError, Details: fmt.Sprintf("node %d hash %s, expected %s", i, gethcrypto.Keccak256Hash(node), root)}
+		}
+	}
+}
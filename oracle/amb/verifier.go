@@ -0,0 +1,364 @@
+package amb
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"oracle/crypto"
+	"oracle/lightclient"
+)
+
+// reasons of TrustlessAMB message execution failures, the messages are the same as contract revert reasons
+var (
+	AlreadyExecutedError    = errors.New("TrustlessAMB: message already executed")
+	MissingStateRootError   = errors.New("TrustlessAMB: stateRoot is missing")
+	EmptyAccountProofError  = errors.New("TrustlessAMB: empty account proof")
+	PayloadProofError       = errors.New("LightClientChain: invalid payload proof")
+	InvalidAccountError     = errors.New("TrustlessAMB: invalid account decoded from RLP")
+	StorageRootError        = errors.New("TrustlessAMB: inconsistent storage root")
+	InvalidMessageHashError = errors.New("TrustlessAMB: invalid message hash")
+	InvalidTargetSlotError  = errors.New("TrustlessAMB: invalid target slot")
+	ReceiptsRootProofError  = errors.New("TrustlessAMB: invalid receipts root proof")
+	InvalidReceiptError     = errors.New("TrustlessAMB: invalid receipt decoded from RLP")
+	MissingLogIndexError    = errors.New("TrustlessAMB: missing log index")
+	InvalidLogError         = errors.New("TrustlessAMB: invalid log decoded from RLP")
+	LogOriginError          = errors.New("TrustlessAMB: invalid log origin")
+	TopicsCountError        = errors.New("TruslessAMB: different topics count expected")
+	EventSignatureError     = errors.New("TruslessAMB: different event signature expected")
+	LogMessageHashError     = errors.New("TruslessAMB: different msgHash in log expected")
+	InvalidProofLengthError = errors.New("invalid proof length")
+	MPTListLengthError      = errors.New("MPT: invalid RLP list length")
+	MPTNodeHashError        = errors.New("MPT: node hash does not match")
+	// DecodeError is reverted without a reason by RLPReader or abi.decode
+	DecodeError        = errors.New("can't decode")
+	ContractPanicError = errors.New("contract panic")
+)
+
+// layers of the message proof, in the order they are checked by the contract
+const (
+	MessageLayer     = "message"
+	BeaconStateLayer = "beacon state"
+	AccountLayer     = "account"
+	StorageLayer     = "storage"
+	ReceiptLayer     = "receipt"
+	LogLayer         = "log"
+)
+
+const (
+	slotsPerHistoricalRoot = 8192
+	historicalRootsLimit   = 1 << 24
+)
+
+var sentMessageEventID = gethcrypto.Keccak256Hash([]byte("SentMessage(bytes32,uint256,bytes)"))
+
+// ProofError is returned for messages, that would be reverted by the contract.
+// It names the proof layer that failed and wraps one of the typed errors above.
+type ProofError struct {
+	Layer   string
+	Reason  error
+	Details string
+}
+
+func (e *ProofError) Error() string {
+	msg := e.Reason.Error()
+	if e.Layer != "" {
+		msg = fmt.Sprintf("%s: %s", e.Layer, msg)
+	}
+	if e.Details != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Details)
+	}
+	return msg
+}
+
+func (e *ProofError) Unwrap() error {
+	return e.Reason
+}
+
+func proofError(layer string, reason error, format string, args ...interface{}) *ProofError {
+	return &ProofError{Layer: layer, Reason: reason, Details: fmt.Sprintf(format, args...)}
+}
+
+// withLayer assigns the layer to the errors returned by readProof
+func withLayer(layer string, err error) error {
+	var proofErr *ProofError
+	if errors.As(err, &proofErr) {
+		proofErr.Layer = layer
+	}
+	return err
+}
+
+// ContractState is the part of TrustlessAMB and BeaconLightClient storage, that affects the execution of a single message
+type ContractState struct {
+	OtherSideAMB common.Address
+	// StateRoot is the beacon state root stored by the light client for the source slot
+	StateRoot common.Hash
+	// StorageRoot is the other side AMB storage root cached by executeMessage for the source slot
+	StorageRoot common.Hash
+	Executed    bool
+}
+
+// Message is the decoded message passed by requireToPassMessage
+type Message struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Receiver common.Address
+	GasLimit *big.Int
+	Data     []byte
+}
+
+var messageArguments = abi.Arguments{
+	{Type: mustNewType("uint256")},
+	{Type: mustNewType("address")},
+	{Type: mustNewType("address")},
+	{Type: mustNewType("uint256")},
+	{Type: mustNewType("bytes")},
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// DecodeMessage mirrors abi.decode of the message in the contract
+func DecodeMessage(message []byte) (*Message, error) {
+	values, err := messageArguments.Unpack(message)
+	if err != nil {
+		return nil, proofError(MessageLayer, DecodeError, "%s", err)
+	}
+	return &Message{
+		Nonce:    values[0].(*big.Int),
+		Sender:   values[1].(common.Address),
+		Receiver: values[2].(common.Address),
+		GasLimit: values[3].(*big.Int),
+		Data:     values[4].([]byte),
+	}, nil
+}
+
+// StorageSlotKey returns the position of sentMessages[nonce] in the AMB storage
+func StorageSlotKey(nonce *big.Int) common.Hash {
+	return gethcrypto.Keccak256Hash(gethcrypto.Keccak256(common.BigToHash(nonce).Bytes(), common.Hash{}.Bytes()))
+}
+
+// VerifyMessage reproduces the proof checks of TrustlessAMB.executeMessage for the given calldata.
+// The message call itself is not simulated, so the execution can still fail because of the gas limit.
+func VerifyMessage(state *ContractState, sourceSlot uint64, message []byte, stateRootProof []common.Hash, accountProof, storageProof [][]byte) error {
+	msgHash := gethcrypto.Keccak256Hash(message)
+	if state.Executed {
+		return proofError(MessageLayer, AlreadyExecutedError, "message hash %s", msgHash)
+	}
+
+	storageRoot := state.StorageRoot
+	if storageRoot == (common.Hash{}) {
+		if state.StateRoot == (common.Hash{}) {
+			return proofError(BeaconStateLayer, MissingStateRootError, "slot %d", sourceSlot)
+		}
+		if len(accountProof) == 0 {
+			return proofError(AccountLayer, EmptyAccountProofError, "storage root for slot %d is not cached yet", sourceSlot)
+		}
+		executionStateRoot := gethcrypto.Keccak256Hash(accountProof[0])
+		if err := verifyBeaconProof(executionStateRoot, payloadStateRootIndex(), stateRootProof, state.StateRoot); err != nil {
+			return withLayer(BeaconStateLayer, err)
+		}
+
+		accountRLP, err := readProof(gethcrypto.Keccak256Hash(state.OtherSideAMB.Bytes()), accountProof)
+		if err != nil {
+			return withLayer(AccountLayer, err)
+		}
+		ls, err := rlpItem(accountRLP).toList()
+		if err != nil {
+			return proofError(AccountLayer, DecodeError, "%s", err)
+		}
+		if len(ls) != 4 {
+			return proofError(AccountLayer, InvalidAccountError, "%d items", len(ls))
+		}
+		storageRoot, err = ls[2].toUint()
+		if err != nil {
+			return proofError(AccountLayer, DecodeError, "storage root: %s", err)
+		}
+	}
+
+	msg, err := DecodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	if len(storageProof) == 0 {
+		return proofError(StorageLayer, ContractPanicError, "empty storage proof")
+	}
+	if root := gethcrypto.Keccak256Hash(storageProof[0]); root != storageRoot {
+		return proofError(StorageLayer, StorageRootError, "proof root %s, account storage root %s", root, storageRoot)
+	}
+	slotValue, err := readProof(StorageSlotKey(msg.Nonce), storageProof)
+	if err != nil {
+		return withLayer(StorageLayer, err)
+	}
+	value, err := rlpItem(slotValue).toUint()
+	if err != nil {
+		return proofError(StorageLayer, DecodeError, "slot value: %s", err)
+	}
+	if value != msgHash {
+		return proofError(StorageLayer, InvalidMessageHashError, "sentMessages[%d] is %s, message hash %s", msg.Nonce, value, msgHash)
+	}
+	return nil
+}
+
+// VerifyMessageFromLog reproduces the proof checks of TrustlessAMB.executeMessageFromLog for the given calldata.
+// The message call itself is not simulated, so the execution can still fail because of the gas limit.
+func VerifyMessageFromLog(state *ContractState, sourceSlot, targetSlot, txIndex, logIndex uint64, message []byte, receiptsRootProof []common.Hash, receiptProof [][]byte) error {
+	msgHash := gethcrypto.Keccak256Hash(message)
+	if state.Executed {
+		return proofError(MessageLayer, AlreadyExecutedError, "message hash %s", msgHash)
+	}
+
+	if state.StateRoot == (common.Hash{}) {
+		return proofError(BeaconStateLayer, MissingStateRootError, "slot %d", sourceSlot)
+	}
+	index, err := receiptsRootIndex(sourceSlot, targetSlot)
+	if err != nil {
+		return err
+	}
+	if len(receiptProof) == 0 {
+		return proofError(ReceiptLayer, ContractPanicError, "empty receipt proof")
+	}
+	receiptsRoot := gethcrypto.Keccak256Hash(receiptProof[0])
+	if err = verifyBeaconProof(receiptsRoot, index, receiptsRootProof, state.StateRoot); err != nil {
+		if errors.Is(err, PayloadProofError) {
+			err.(*ProofError).Reason = ReceiptsRootProofError
+		}
+		return withLayer(BeaconStateLayer, err)
+	}
+
+	receiptRLP, err := readProof(rlpIndex(txIndex), receiptProof)
+	if err != nil {
+		err = withLayer(ReceiptLayer, err)
+		if txIndex > 0 && txIndex < 128 && errors.Is(err, MPTNodeHashError) {
+			err.(*ProofError).Details += fmt.Sprintf(", the contract looks up tx index %d by the key of tx index 0", txIndex)
+		}
+		return err
+	}
+	// typed receipts are prefixed with the transaction type
+	if len(receiptRLP) > 0 && !rlpItem(receiptRLP).isList() {
+		receiptRLP = receiptRLP[1:]
+	}
+	ls, err := rlpItem(receiptRLP).toList()
+	if err != nil {
+		return proofError(ReceiptLayer, DecodeError, "%s", err)
+	}
+	if len(ls) != 4 {
+		return proofError(ReceiptLayer, InvalidReceiptError, "%d items", len(ls))
+	}
+	logs, err := ls[3].toList()
+	if err != nil {
+		return proofError(ReceiptLayer, DecodeError, "logs: %s", err)
+	}
+	if logIndex >= uint64(len(logs)) {
+		return proofError(LogLayer, MissingLogIndexError, "log index %d, receipt has %d logs", logIndex, len(logs))
+	}
+	ls, err = logs[logIndex].toList()
+	if err != nil {
+		return proofError(LogLayer, DecodeError, "%s", err)
+	}
+	if len(ls) != 3 {
+		return proofError(LogLayer, InvalidLogError, "%d items", len(ls))
+	}
+	origin, err := ls[0].toAddress()
+	if err != nil {
+		return proofError(LogLayer, DecodeError, "address: %s", err)
+	}
+	if origin != state.OtherSideAMB {
+		return proofError(LogLayer, LogOriginError, "log address %s, other side AMB %s", origin, state.OtherSideAMB)
+	}
+	topics, err := ls[1].toList()
+	if err != nil {
+		return proofError(LogLayer, DecodeError, "topics: %s", err)
+	}
+	if len(topics) != 3 {
+		return proofError(LogLayer, TopicsCountError, "%d topics", len(topics))
+	}
+	topic, err := topics[0].toUintStrict()
+	if err != nil {
+		return proofError(LogLayer, DecodeError, "topic 0: %s", err)
+	}
+	if topic != sentMessageEventID {
+		return proofError(LogLayer, EventSignatureError, "topic %s", topic)
+	}
+	topic, err = topics[1].toUintStrict()
+	if err != nil {
+		return proofError(LogLayer, DecodeError, "topic 1: %s", err)
+	}
+	if topic != msgHash {
+		return proofError(LogLayer, LogMessageHashError, "topic %s, message hash %s", topic, msgHash)
+	}
+
+	_, err = DecodeMessage(message)
+	return err
+}
+
+// payloadStateRootIndex is get_generalized_index(BeaconState, 'latest_execution_payload_header', 'state_root')
+func payloadStateRootIndex() int {
+	gi := lightclient.ContractGenIndices
+	return crypto.ConcatGenIndices(gi.LatestExecutionPayloadHeader, gi.PayloadStateRoot)
+}
+
+// receiptsRootIndex mirrors the generalized index selection of executeMessageFromLog
+func receiptsRootIndex(sourceSlot, targetSlot uint64) (int, error) {
+	gi := lightclient.ContractGenIndices
+	switch {
+	case targetSlot == sourceSlot:
+		return crypto.ConcatGenIndices(gi.LatestExecutionPayloadHeader, gi.PayloadReceiptsRoot), nil
+	case targetSlot+slotsPerHistoricalRoot <= sourceSlot:
+		return crypto.ConcatGenIndices(
+			gi.HistoricalRoots,
+			2+0, // HistoricalBatch.block_roots, state_roots
+			historicalRootsLimit+int(targetSlot/slotsPerHistoricalRoot),
+			2+1,
+			slotsPerHistoricalRoot+int(targetSlot%slotsPerHistoricalRoot),
+			gi.LatestExecutionPayloadHeader,
+			gi.PayloadReceiptsRoot,
+		), nil
+	case targetSlot < sourceSlot:
+		return crypto.ConcatGenIndices(
+			gi.StateRoots,
+			slotsPerHistoricalRoot+int(targetSlot%slotsPerHistoricalRoot),
+			gi.LatestExecutionPayloadHeader,
+			gi.PayloadReceiptsRoot,
+		), nil
+	default:
+		return 0, proofError(BeaconStateLayer, InvalidTargetSlotError, "target slot %d is after source slot %d", targetSlot, sourceSlot)
+	}
+}
+
+// verifyBeaconProof mirrors Merkle.restoreMerkleRoot with the following comparison against the light client state root
+func verifyBeaconProof(leaf common.Hash, genIndex int, proof []common.Hash, stateRoot common.Hash) error {
+	if genIndex>>len(proof) != 1 {
+		return proofError("", InvalidProofLengthError, "index %d, %d proof items", genIndex, len(proof))
+	}
+	root := crypto.NewMerkleProof(genIndex, proof).ReconstructRoot(leaf)
+	if root != stateRoot {
+		return proofError("", PayloadProofError, "restored state root %s for index %d, light client state root %s", root, genIndex, stateRoot)
+	}
+	return nil
+}
+
+// rlpIndex mirrors TrustlessAMB.rlpIndex. Note that the contract uses the key of tx index 0 for indices 1..127,
+// so their receipts can't be proven until the contract is fixed.
+func rlpIndex(v uint64) common.Hash {
+	var key common.Hash
+	switch {
+	case v < 128:
+		key[0] = 0x80
+	case v < 256:
+		key[0], key[1] = 0x81, byte(v)
+	default:
+		key[0], key[1], key[2] = 0x82, byte(v>>8), byte(v)
+	}
+	return key
+}
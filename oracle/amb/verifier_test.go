@@ -0,0 +1,349 @@
+package amb
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/crypto"
+)
+
+type proofList [][]byte
+
+func (l *proofList) Put(_ []byte, value []byte) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func (l *proofList) Delete([]byte) error {
+	return nil
+}
+
+func newTrie(t *testing.T) *trie.Trie {
+	tr, err := trie.New(common.Hash{}, trie.NewDatabase(memorydb.New()))
+	require.NoError(t, err)
+	return tr
+}
+
+func prove(t *testing.T, tr *trie.Trie, key []byte) [][]byte {
+	var proof proofList
+	require.NoError(t, tr.Prove(key, 0, &proof))
+	return proof
+}
+
+// proveBeaconLeaf makes a random beacon state proof for the leaf and returns the matching state root
+func proveBeaconLeaf(leaf common.Hash, genIndex int) ([]common.Hash, common.Hash) {
+	path := make([]common.Hash, bits.Len(uint(genIndex))-1)
+	for i := range path {
+		path[i] = common.BigToHash(big.NewInt(int64(i + 1)))
+	}
+	return path, crypto.NewMerkleProof(genIndex, path).ReconstructRoot(leaf)
+}
+
+func makeMessage(t *testing.T, nonce int64) []byte {
+	msg, err := messageArguments.Pack(big.NewInt(nonce), common.Address{1}, common.Address{2}, big.NewInt(100000), []byte{1, 2, 3})
+	require.NoError(t, err)
+	return msg
+}
+
+func TestVerifyMessage(t *testing.T) {
+	otherSideAMB := common.Address{0xaa}
+	message := makeMessage(t, 5)
+
+	storage := newTrie(t)
+	for nonce := int64(0); nonce < 10; nonce++ {
+		hash := gethcrypto.Keccak256Hash(makeMessage(t, nonce))
+		value, err := rlp.EncodeToBytes(common.TrimLeftZeroes(hash.Bytes()))
+		require.NoError(t, err)
+		storage.Update(StorageSlotKey(big.NewInt(nonce)).Bytes(), value)
+	}
+	accounts := newTrie(t)
+	for i := byte(0); i < 20; i++ {
+		account := &types.StateAccount{Balance: big.NewInt(int64(i)), Root: types.EmptyRootHash, CodeHash: gethcrypto.Keccak256(nil)}
+		if i == 0 {
+			account.Root = storage.Hash()
+		}
+		value, err := rlp.EncodeToBytes(account)
+		require.NoError(t, err)
+		accounts.Update(gethcrypto.Keccak256(common.Address{0xaa + i}.Bytes()), value)
+	}
+	accountProof := prove(t, accounts, gethcrypto.Keccak256(otherSideAMB.Bytes()))
+	storageProof := prove(t, storage, StorageSlotKey(big.NewInt(5)).Bytes())
+	stateRootProof, stateRoot := proveBeaconLeaf(accounts.Hash(), payloadStateRootIndex())
+	state := func() *ContractState {
+		return &ContractState{OtherSideAMB: otherSideAMB, StateRoot: stateRoot}
+	}
+
+	require.NoError(t, VerifyMessage(state(), 100, message, stateRootProof, accountProof, storageProof))
+	// cached storage root doesn't need the account proof
+	cached := &ContractState{OtherSideAMB: otherSideAMB, StorageRoot: storage.Hash()}
+	require.NoError(t, VerifyMessage(cached, 100, message, nil, nil, storageProof))
+
+	for name, tc := range map[string]struct {
+		state          func(s *ContractState)
+		message        []byte
+		stateRootProof []common.Hash
+		accountProof   [][]byte
+		storageProof   [][]byte
+		layer          string
+		reason         error
+	}{
+		"executed": {
+			state:  func(s *ContractState) { s.Executed = true },
+			layer:  MessageLayer,
+			reason: AlreadyExecutedError,
+		},
+		"missing state root": {
+			state:  func(s *ContractState) { s.StateRoot = common.Hash{} },
+			layer:  BeaconStateLayer,
+			reason: MissingStateRootError,
+		},
+		"empty account proof": {
+			accountProof: [][]byte{},
+			layer:        AccountLayer,
+			reason:       EmptyAccountProofError,
+		},
+		"state root proof length": {
+			stateRootProof: stateRootProof[1:],
+			layer:          BeaconStateLayer,
+			reason:         InvalidProofLengthError,
+		},
+		"state root proof": {
+			state:  func(s *ContractState) { s.StateRoot = common.Hash{1} },
+			layer:  BeaconStateLayer,
+			reason: PayloadProofError,
+		},
+		"other account": {
+			state:  func(s *ContractState) { s.OtherSideAMB = common.Address{0xab} },
+			layer:  AccountLayer,
+			reason: MPTNodeHashError,
+		},
+		"truncated account proof": {
+			accountProof: accountProof[:len(accountProof)-1],
+			layer:        AccountLayer,
+			reason:       ContractPanicError,
+		},
+		"stale storage root": {
+			state:  func(s *ContractState) { s.StorageRoot = common.Hash{1} },
+			layer:  StorageLayer,
+			reason: StorageRootError,
+		},
+		"other message with the same nonce": {
+			message: append(makeMessage(t, 5), 0),
+			layer:   StorageLayer,
+			reason:  InvalidMessageHashError,
+		},
+		"unknown message": {
+			message: makeMessage(t, 50),
+			layer:   StorageLayer,
+			reason:  MPTNodeHashError,
+		},
+		"malformed message": {
+			message: message[:100],
+			layer:   MessageLayer,
+			reason:  DecodeError,
+		},
+	} {
+		s := state()
+		if tc.state != nil {
+			tc.state(s)
+		}
+		if tc.message == nil {
+			tc.message = message
+		}
+		if tc.stateRootProof == nil {
+			tc.stateRootProof = stateRootProof
+		}
+		if tc.accountProof == nil {
+			tc.accountProof = accountProof
+		}
+		if tc.storageProof == nil {
+			tc.storageProof = storageProof
+		}
+		err := VerifyMessage(s, 100, tc.message, tc.stateRootProof, tc.accountProof, tc.storageProof)
+		require.ErrorIs(t, err, tc.reason, name)
+		var proofErr *ProofError
+		require.True(t, errors.As(err, &proofErr), name)
+		assert.Equal(t, tc.layer, proofErr.Layer, name)
+	}
+}
+
+func TestVerifyMessageFromLog(t *testing.T) {
+	otherSideAMB := common.Address{0xaa}
+	message := makeMessage(t, 5)
+	msgHash := gethcrypto.Keccak256Hash(message)
+	sentLog := &types.Log{
+		Address: otherSideAMB,
+		Topics:  []common.Hash{sentMessageEventID, msgHash, common.BigToHash(big.NewInt(5))},
+		Data:    message,
+	}
+
+	// the message is sent by the transactions 0, 1 and 200, the last one is a legacy transaction without the type prefix
+	receipts := newTrie(t)
+	for i := 0; i < 300; i++ {
+		receipt := &types.Receipt{Type: types.DynamicFeeTxType, Status: 1, CumulativeGasUsed: uint64(i)}
+		if i == 0 || i == 1 || i == 200 {
+			receipt.Logs = []*types.Log{{Address: common.Address{1}}, sentLog}
+		}
+		if i == 200 {
+			receipt.Type = types.LegacyTxType
+		}
+		value, err := receipt.MarshalBinary()
+		require.NoError(t, err)
+		receipts.Update(rlp.AppendUint64(nil, uint64(i)), value)
+	}
+	receiptProof := func(txIndex uint64) [][]byte {
+		return prove(t, receipts, rlp.AppendUint64(nil, txIndex))
+	}
+	stateRoot := func(sourceSlot, targetSlot uint64) ([]common.Hash, common.Hash) {
+		index, err := receiptsRootIndex(sourceSlot, targetSlot)
+		require.NoError(t, err)
+		return proveBeaconLeaf(receipts.Hash(), index)
+	}
+
+	for _, tc := range []struct {
+		sourceSlot, targetSlot uint64
+	}{
+		{100, 100},
+		{100, 90},
+		{20000, 100},
+	} {
+		proof, root := stateRoot(tc.sourceSlot, tc.targetSlot)
+		state := &ContractState{OtherSideAMB: otherSideAMB, StateRoot: root}
+		require.NoError(t, VerifyMessageFromLog(state, tc.sourceSlot, tc.targetSlot, 0, 1, message, proof, receiptProof(0)), "%v", tc)
+		require.NoError(t, VerifyMessageFromLog(state, tc.sourceSlot, tc.targetSlot, 200, 1, message, proof, receiptProof(200)), "%v", tc)
+	}
+
+	proof, root := stateRoot(100, 100)
+	state := func() *ContractState {
+		return &ContractState{OtherSideAMB: otherSideAMB, StateRoot: root}
+	}
+	for name, tc := range map[string]struct {
+		state      func(s *ContractState)
+		targetSlot uint64
+		txIndex    uint64
+		logIndex   uint64
+		message    []byte
+		layer      string
+		reason     error
+	}{
+		"executed": {
+			state:    func(s *ContractState) { s.Executed = true },
+			logIndex: 1,
+			layer:    MessageLayer,
+			reason:   AlreadyExecutedError,
+		},
+		"missing state root": {
+			state:    func(s *ContractState) { s.StateRoot = common.Hash{} },
+			logIndex: 1,
+			layer:    BeaconStateLayer,
+			reason:   MissingStateRootError,
+		},
+		"future target slot": {
+			targetSlot: 101,
+			logIndex:   1,
+			layer:      BeaconStateLayer,
+			reason:     InvalidTargetSlotError,
+		},
+		"other target slot": {
+			targetSlot: 99,
+			logIndex:   1,
+			layer:      BeaconStateLayer,
+			reason:     InvalidProofLengthError,
+		},
+		"receipts root proof": {
+			state:    func(s *ContractState) { s.StateRoot = common.Hash{1} },
+			logIndex: 1,
+			layer:    BeaconStateLayer,
+			reason:   ReceiptsRootProofError,
+		},
+		// the contract uses the key of tx 0 for tx indices 1..127
+		"tx index 1": {
+			txIndex:  1,
+			logIndex: 1,
+			layer:    ReceiptLayer,
+			reason:   MPTNodeHashError,
+		},
+		"missing log": {
+			logIndex: 2,
+			layer:    LogLayer,
+			reason:   MissingLogIndexError,
+		},
+		"other log": {
+			logIndex: 0,
+			layer:    LogLayer,
+			reason:   LogOriginError,
+		},
+		"other side AMB": {
+			state:    func(s *ContractState) { s.OtherSideAMB = common.Address{1} },
+			logIndex: 0,
+			layer:    LogLayer,
+			reason:   TopicsCountError,
+		},
+		"other message": {
+			message:  makeMessage(t, 6),
+			logIndex: 1,
+			layer:    LogLayer,
+			reason:   LogMessageHashError,
+		},
+	} {
+		s := state()
+		if tc.state != nil {
+			tc.state(s)
+		}
+		if tc.targetSlot == 0 {
+			tc.targetSlot = 100
+		}
+		if tc.message == nil {
+			tc.message = message
+		}
+		err := VerifyMessageFromLog(s, 100, tc.targetSlot, tc.txIndex, tc.logIndex, tc.message, proof, receiptProof(tc.txIndex))
+		require.ErrorIs(t, err, tc.reason, name)
+		var proofErr *ProofError
+		require.True(t, errors.As(err, &proofErr), name)
+		assert.Equal(t, tc.layer, proofErr.Layer, name)
+		if name == "tx index 1" {
+			assert.Contains(t, err.Error(), "the contract looks up tx index 1 by the key of tx index 0")
+		}
+	}
+}
+
+func TestReceiptsRootIndex(t *testing.T) {
+	// the same arithmetic as in executeMessageFromLog
+	index, err := receiptsRootIndex(100, 100)
+	require.NoError(t, err)
+	assert.Equal(t, (32+24)*16+3, index)
+
+	index, err = receiptsRootIndex(100, 90)
+	require.NoError(t, err)
+	assert.Equal(t, (((32+6)*8192+90)*32+24)*16+3, index)
+
+	index, err = receiptsRootIndex(40000, 20000)
+	require.NoError(t, err)
+	expected := (32+7)*2 + 0
+	expected = expected*(1<<24) + 20000/8192
+	expected = expected*2 + 1
+	expected = expected*8192 + 20000%8192
+	expected = (expected*32+24)*16 + 3
+	assert.Equal(t, expected, index)
+
+	assert.Equal(t, (32+24)*16+2, payloadStateRootIndex())
+}
+
+func TestRLPIndex(t *testing.T) {
+	assert.Equal(t, common.Hash{0x80}, rlpIndex(0))
+	assert.Equal(t, common.Hash{0x80}, rlpIndex(127))
+	assert.Equal(t, common.Hash{0x81, 200}, rlpIndex(200))
+	assert.Equal(t, common.Hash{0x82, 0x01, 0x2c}, rlpIndex(300))
+	assert.Equal(t, common.BytesToHash(common.RightPadBytes(rlp.AppendUint64(nil, 300), 32)), rlpIndex(300))
+}
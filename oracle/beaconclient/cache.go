@@ -0,0 +1,278 @@
+package beaconclient
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"oracle/forks"
+)
+
+// StateFieldRootsCache is implemented by clients, that can persist hash tree roots of the beacon state fields,
+// so that state merkle trees don't have to be recomputed for the already seen states
+type StateFieldRootsCache interface {
+	GetStateFieldRoots(slot uint64) ([]common.Hash, bool)
+	PutStateFieldRoots(slot uint64, roots []common.Hash)
+}
+
+var (
+	_ Eth2Client           = (*CachedClient)(nil)
+	_ StateFieldRootsCache = (*CachedClient)(nil)
+	_ InvalidDataReporter  = (*CachedClient)(nil)
+)
+
+// CachedClient is an Eth2Client decorator, storing ssz encoded blocks by root and states by slot in a local directory.
+// Blocks are immutable and are always cached, while states are only cached once their slot is finalized.
+// When the total size of the cached files exceeds the limit, least recently used files are evicted.
+type CachedClient struct {
+	Eth2Client
+	dir     string
+	maxSize int64
+
+	mu    sync.Mutex
+	files map[string]*cacheFile
+	size  int64
+}
+
+type cacheFile struct {
+	size     int64
+	lastUsed time.Time
+}
+
+// NewCachedClient creates a caching decorator for the given client, maxSize <= 0 disables eviction
+func NewCachedClient(client Eth2Client, dir string, maxSize int64) (*CachedClient, error) {
+	c := &CachedClient{
+		Eth2Client: client,
+		dir:        dir,
+		maxSize:    maxSize,
+		files:      make(map[string]*cacheFile),
+	}
+	for _, sub := range []string{"blocks", "states"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("can't create cache directory: %w", err)
+		}
+	}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if strings.HasSuffix(path, ".tmp") {
+			// leftover of the interrupted write
+			return os.Remove(path)
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		c.files[name] = &cacheFile{size: info.Size(), lastUsed: info.ModTime()}
+		c.size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't read cache directory: %w", err)
+	}
+	return c, nil
+}
+
+func (c *CachedClient) GetBlock(ctx context.Context, id string) (*forks.BeaconBlock, error) {
+	var root common.Hash
+	if strings.HasPrefix(id, "0x") {
+		root = common.HexToHash(id)
+	} else {
+		var err error
+		// resolving the root is much cheaper than fetching the whole block
+		root, err = c.Eth2Client.GetBlockRoot(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	name := filepath.Join("blocks", root.Hex()+".ssz")
+	if version, data, ok := c.read(name); ok {
+		block, err := forks.DecodeSignedBeaconBlock(version, data)
+		if err == nil {
+			return block, nil
+		}
+		log.Printf("Can't decode cached block %s: %s\n", root, err)
+		c.remove(name)
+	}
+	block, err := c.Eth2Client.GetBlock(ctx, root.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if block.Root() != root {
+		log.Printf("Block %s has a different root %s, not caching it\n", root, block.Root())
+		return block, nil
+	}
+	if data, err2 := block.Signed.MarshalSSZ(); err2 == nil {
+		c.write(name, block.Version, data)
+	}
+	return block, nil
+}
+
+func (c *CachedClient) GetState(ctx context.Context, slot uint64) (*forks.BeaconState, error) {
+	name := filepath.Join("states", strconv.FormatUint(slot, 10)+".ssz")
+	if version, data, ok := c.read(name); ok {
+		state, err := forks.DecodeBeaconState(version, data)
+		if err == nil {
+			return state, nil
+		}
+		log.Printf("Can't decode cached state %d: %s\n", slot, err)
+		c.remove(name)
+	}
+	state, err := c.Eth2Client.GetState(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if !c.isFinalized(ctx, slot) {
+		return state, nil
+	}
+	if data, err2 := state.Raw.MarshalSSZ(); err2 == nil {
+		c.write(name, state.Version, data)
+	}
+	return state, nil
+}
+
+func (c *CachedClient) GetStateFieldRoots(slot uint64) ([]common.Hash, bool) {
+	_, data, ok := c.read(filepath.Join("states", strconv.FormatUint(slot, 10)+".roots"))
+	if !ok || len(data)%32 != 0 {
+		return nil, false
+	}
+	roots := make([]common.Hash, len(data)/32)
+	for i := range roots {
+		roots[i] = common.BytesToHash(data[i*32 : i*32+32])
+	}
+	return roots, true
+}
+
+// PutStateFieldRoots persists the given roots, only if the state itself was cached
+func (c *CachedClient) PutStateFieldRoots(slot uint64, roots []common.Hash) {
+	c.mu.Lock()
+	_, ok := c.files[filepath.Join("states", strconv.FormatUint(slot, 10)+".ssz")]
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+	var data []byte
+	for _, root := range roots {
+		data = append(data, root.Bytes()...)
+	}
+	c.write(filepath.Join("states", strconv.FormatUint(slot, 10)+".roots"), 0, data)
+}
+
+// ReportInvalidData removes the cached block or state, so that it is fetched again, and forwards the report to the underlying client
+func (c *CachedClient) ReportInvalidData(response interface{}) {
+	switch r := response.(type) {
+	case *forks.BeaconBlock:
+		c.remove(filepath.Join("blocks", r.Root().Hex()+".ssz"))
+	case *forks.BeaconState:
+		c.remove(filepath.Join("states", strconv.FormatUint(r.Slot, 10)+".ssz"))
+		c.remove(filepath.Join("states", strconv.FormatUint(r.Slot, 10)+".roots"))
+	}
+	if reporter, ok := c.Eth2Client.(InvalidDataReporter); ok {
+		reporter.ReportInvalidData(response)
+	}
+}
+
+func (c *CachedClient) isFinalized(ctx context.Context, slot uint64) bool {
+	root, err := c.Eth2Client.GetBlockRoot(ctx, "finalized")
+	if err != nil {
+		log.Printf("Can't get finalized block root: %s\n", err)
+		return false
+	}
+	block, err := c.GetBlock(ctx, root.Hex())
+	if err != nil {
+		log.Printf("Can't get finalized block: %s\n", err)
+		return false
+	}
+	return slot <= block.Slot
+}
+
+// read returns the cached object together with its fork version, stored in the first byte of the file
+func (c *CachedClient) read(name string) (forks.Version, []byte, bool) {
+	c.mu.Lock()
+	file, ok := c.files[name]
+	if ok {
+		file.lastUsed = time.Now()
+	}
+	c.mu.Unlock()
+	if !ok {
+		return 0, nil, false
+	}
+
+	path := filepath.Join(c.dir, name)
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) == 0 {
+		log.Printf("Can't read cached file %s: %v\n", name, err)
+		c.remove(name)
+		return 0, nil, false
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return forks.Version(data[0]), data[1:], true
+}
+
+func (c *CachedClient) write(name string, version forks.Version, data []byte) {
+	path := filepath.Join(c.dir, name)
+	tmp := path + ".tmp"
+	err := os.WriteFile(tmp, append([]byte{byte(version)}, data...), 0o644)
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		log.Printf("Can't write cached file %s: %s\n", name, err)
+		_ = os.Remove(tmp)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if file, ok := c.files[name]; ok {
+		c.size -= file.size
+	}
+	c.files[name] = &cacheFile{size: int64(len(data) + 1), lastUsed: time.Now()}
+	c.size += int64(len(data) + 1)
+	c.evict(name)
+}
+
+// evict removes least recently used files until the cache fits into the size limit, keeping the given file
+func (c *CachedClient) evict(keep string) {
+	for c.maxSize > 0 && c.size > c.maxSize {
+		var oldest string
+		for name, file := range c.files {
+			if name != keep && (oldest == "" || file.lastUsed.Before(c.files[oldest].lastUsed)) {
+				oldest = name
+			}
+		}
+		if oldest == "" {
+			return
+		}
+		if err := os.Remove(filepath.Join(c.dir, oldest)); err != nil && !os.IsNotExist(err) {
+			log.Printf("Can't evict cached file %s: %s\n", oldest, err)
+		}
+		c.size -= c.files[oldest].size
+		delete(c.files, oldest)
+	}
+}
+
+func (c *CachedClient) remove(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if file, ok := c.files[name]; ok {
+		_ = os.Remove(filepath.Join(c.dir, name))
+		c.size -= file.size
+		delete(c.files, name)
+	}
+}
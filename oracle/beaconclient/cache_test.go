@@ -0,0 +1,126 @@
+package beaconclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/forks"
+)
+
+type countingClient struct {
+	Eth2Client
+	blocks    map[common.Hash]*forks.BeaconBlock
+	finalized common.Hash
+	calls     int
+}
+
+func (c *countingClient) GetBlock(ctx context.Context, id string) (*forks.BeaconBlock, error) {
+	c.calls++
+	if block, ok := c.blocks[common.HexToHash(id)]; ok {
+		return block, nil
+	}
+	return nil, NotFoundError
+}
+
+func (c *countingClient) GetBlockRoot(ctx context.Context, id string) (common.Hash, error) {
+	if id == "finalized" {
+		return c.finalized, nil
+	}
+	for root, block := range c.blocks {
+		if id == root.Hex() || id == "head" && block.Slot == 2 {
+			return root, nil
+		}
+	}
+	return common.Hash{}, NotFoundError
+}
+
+func newTestBlock(t *testing.T, slot uint64) *forks.BeaconBlock {
+	signed := &forks.SignedBeaconBlockBellatrix{
+		Message: &forks.BeaconBlockBellatrix{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			Body: &forks.BeaconBlockBodyBellatrix{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data:     &forks.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+				Graffiti:     make([]byte, 32),
+				SyncAggregate: &forks.SyncAggregate{
+					SyncCommitteeBits:      make([]byte, 64),
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+				ExecutionPayload: &forks.ExecutionPayloadBellatrix{
+					ParentHash:    make([]byte, 32),
+					FeeRecipient:  make([]byte, 20),
+					StateRoot:     make([]byte, 32),
+					ReceiptsRoot:  make([]byte, 32),
+					LogsBloom:     make([]byte, 256),
+					PrevRandao:    make([]byte, 32),
+					BaseFeePerGas: make([]byte, 32),
+					BlockHash:     make([]byte, 32),
+				},
+			},
+		},
+		Signature: make([]byte, 96),
+	}
+	block, err := forks.NewBeaconBlock(forks.Bellatrix, signed)
+	require.NoError(t, err)
+	return block
+}
+
+func TestCachedClient(t *testing.T) {
+	dir := t.TempDir()
+	b1, b2 := newTestBlock(t, 1), newTestBlock(t, 2)
+	inner := &countingClient{blocks: map[common.Hash]*forks.BeaconBlock{b1.Root(): b1, b2.Root(): b2}}
+
+	c, err := NewCachedClient(inner, dir, 0)
+	require.NoError(t, err)
+	block, err := c.GetBlock(context.Background(), "head")
+	require.NoError(t, err)
+	assert.Equal(t, b2.Root(), block.Root())
+	_, err = c.GetBlock(context.Background(), b2.Root().Hex())
+	require.NoError(t, err)
+	assert.Equal(t, 1, inner.calls)
+
+	_, err = c.GetBlock(context.Background(), common.Hash{1}.Hex())
+	assert.ErrorIs(t, err, NotFoundError)
+
+	// cache is persisted between runs
+	inner.calls = 0
+	c, err = NewCachedClient(inner, dir, 0)
+	require.NoError(t, err)
+	block, err = c.GetBlock(context.Background(), b2.Root().Hex())
+	require.NoError(t, err)
+	assert.Equal(t, b2.Root(), block.Root())
+	assert.Equal(t, 0, inner.calls)
+
+	// least recently used block is evicted, once the limit is exceeded
+	c, err = NewCachedClient(inner, dir, c.size+c.size/2)
+	require.NoError(t, err)
+	_, err = c.GetBlock(context.Background(), b1.Root().Hex())
+	require.NoError(t, err)
+	assert.Equal(t, 1, inner.calls)
+	_, err = c.GetBlock(context.Background(), b2.Root().Hex())
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.calls)
+}
+
+func TestCachedClientFieldRoots(t *testing.T) {
+	c, err := NewCachedClient(&countingClient{}, t.TempDir(), 0)
+	require.NoError(t, err)
+
+	roots := []common.Hash{{1}, {2}, {3}}
+	// roots are not stored without the state itself
+	c.PutStateFieldRoots(10, roots)
+	_, ok := c.GetStateFieldRoots(10)
+	assert.False(t, ok)
+
+	c.write("states/10.ssz", forks.Bellatrix, []byte{1})
+	c.PutStateFieldRoots(10, roots)
+	res, ok := c.GetStateFieldRoots(10)
+	require.True(t, ok)
+	assert.Equal(t, roots, res)
+}
@@ -1,116 +1,295 @@
 package beaconclient
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
-	ethpb2 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/ethereum/go-ethereum/common"
+
+	"oracle/config"
+	"oracle/fixtures"
+	"oracle/forks"
 )
 
 type Eth2Client interface {
-	GetSpec() (*ModelSpecData, error)
-	GetGenesis() (*ModelGenesisData, error)
-	GetBlock(id string) (*ethpb2.BeaconBlockBellatrix, error)
-	GetState(slot uint64) (*ethpb2.BeaconStateBellatrix, error)
+	GetSpec(ctx context.Context) (*ModelSpecData, error)
+	GetGenesis(ctx context.Context) (*ModelGenesisData, error)
+	GetBlock(ctx context.Context, id string) (*forks.BeaconBlock, error)
+	GetBlockRoot(ctx context.Context, id string) (common.Hash, error)
+	GetState(ctx context.Context, slot uint64) (*forks.BeaconState, error)
+	// GetFinalityCheckpoints returns the checkpoints of the state at the given slot, without downloading the whole state
+	GetFinalityCheckpoints(ctx context.Context, slot uint64) (*ModelFinalityCheckpointsData, error)
+	GetLightClientBootstrap(ctx context.Context, blockRoot common.Hash) (*ModelLightClientBootstrapData, error)
+	GetLightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*ModelLightClientUpdateData, error)
+	GetLightClientFinalityUpdate(ctx context.Context) (*ModelLightClientUpdateData, error)
+	GetLightClientOptimisticUpdate(ctx context.Context) (*ModelLightClientUpdateData, error)
+}
+
+// SyncStatusReporter is implemented by clients, that can tell whether the underlying beacon node is synced
+type SyncStatusReporter interface {
+	GetSyncing(ctx context.Context) (*ModelSyncingData, error)
+}
+
+// InvalidDataReporter is implemented by clients, that can move away from the beacon node, which returned malformed data
+type InvalidDataReporter interface {
+	// ReportInvalidData is called with the response, e.g. *forks.BeaconBlock, in which InvalidDataError is found
+	ReportInvalidData(response interface{})
 }
 
-var NotFoundError = errors.New("not found")
+var (
+	_ Eth2Client         = (*BeaconClient)(nil)
+	_ SyncStatusReporter = (*BeaconClient)(nil)
+)
 
-var _ Eth2Client = (*BeaconClient)(nil)
+const (
+	defaultTimeout       = 10 * time.Minute
+	defaultRetryDelay    = time.Second
+	defaultMaxRetryDelay = 30 * time.Second
+)
 
 type BeaconClient struct {
-	baseUrl string
-	c       *http.Client
+	baseUrl       string
+	c             *http.Client
+	retries       int
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
 }
 
-func NewClient(baseUrl string) Eth2Client {
-	return &BeaconClient{
+// NewClient creates a client for the given beacon node, using timeouts and retry policy from the config
+func NewClient(baseUrl string, cfg config.HTTPClientConfig) Eth2Client {
+	b := &BeaconClient{
 		baseUrl: baseUrl,
 		c: &http.Client{
-			Transport: http.DefaultTransport,
-			Timeout:   10 * time.Minute,
+			Transport: fixtures.NewTransport(cfg),
+			Timeout:   cfg.Timeout,
 		},
+		retries:       cfg.Retries,
+		retryDelay:    cfg.RetryDelay,
+		maxRetryDelay: cfg.MaxRetryDelay,
+	}
+	if b.c.Timeout == 0 {
+		b.c.Timeout = defaultTimeout
+	}
+	if b.retryDelay == 0 {
+		b.retryDelay = defaultRetryDelay
+	}
+	if b.maxRetryDelay == 0 {
+		b.maxRetryDelay = defaultMaxRetryDelay
 	}
+	return b
 }
 
-func (b *BeaconClient) get(url string, out interface{}, ssz bool) error {
+// NewReplayClient creates a client serving responses recorded with config.HTTPClientConfig.RecordDir
+func NewReplayClient(dir string) Eth2Client {
+	return NewClient("http://replay", config.HTTPClientConfig{ReplayDir: dir})
+}
+
+// request performs GET request, retrying failures that are not specific to the request itself with exponential backoff
+func (b *BeaconClient) request(ctx context.Context, url string, ssz bool) (*http.Response, error) {
+	delay := b.retryDelay
+	for attempt := 0; ; attempt++ {
+		res, err := b.doRequest(ctx, url, ssz)
+		if err == nil || attempt >= b.retries || ctx.Err() != nil || !isRetryable(err) {
+			return res, err
+		}
+		log.Printf("Beacon API request failed, retrying in %s: %s\n", delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if delay *= 2; delay > b.maxRetryDelay {
+			delay = b.maxRetryDelay
+		}
+	}
+}
+
+func (b *BeaconClient) doRequest(ctx context.Context, url string, ssz bool) (*http.Response, error) {
 	fullUrl := b.baseUrl + url
-	req, err := http.NewRequest("GET", fullUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fullUrl, nil)
 	if err != nil {
-		return fmt.Errorf("can't make request from url: %w", err)
+		return nil, fmt.Errorf("can't make request from url: %w", err)
 	}
 	if ssz {
 		req.Header.Set("Accept", "application/octet-stream")
 	}
 	res, err := b.c.Do(req)
 	if err != nil {
-		return fmt.Errorf("can't fetch from url: %w", err)
+		return nil, fmt.Errorf("can't fetch from url: %w", err)
 	}
-	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusBadRequest {
-			return NotFoundError
-		}
-		return fmt.Errorf("got error status code: %d", res.StatusCode)
+		defer res.Body.Close()
+		body := new(ModelError)
+		_ = json.NewDecoder(io.LimitReader(res.Body, 1<<16)).Decode(body)
+		return nil, newAPIError(res.StatusCode, body.Message)
+	}
+	if ssz && strings.EqualFold(res.Header.Get("Eth-Execution-Optimistic"), "true") {
+		res.Body.Close()
+		return nil, ExecutionOptimisticError
 	}
+	return res, nil
+}
 
-	if ssz {
-		data, err := io.ReadAll(res.Body)
-		if err != nil {
-			return fmt.Errorf("can't read ssz: %w", err)
-		}
-		outSSZ := out.(interface{ UnmarshalSSZ(buf []byte) error })
-		err = outSSZ.UnmarshalSSZ(data)
-		if err != nil {
-			return fmt.Errorf("can't unmarshal ssz: %w", err)
+func (b *BeaconClient) get(ctx context.Context, url string, out interface{}) error {
+	res, err := b.request(ctx, url, false)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("can't read json: %w", err)
+	}
+	if len(data) > 0 && data[0] == '{' {
+		meta := new(ModelResponseMeta)
+		if err = json.Unmarshal(data, meta); err == nil && meta.ExecutionOptimistic {
+			return ExecutionOptimisticError
 		}
-		return nil
 	}
-	err = json.NewDecoder(res.Body).Decode(out)
+	err = json.Unmarshal(data, out)
 	if err != nil {
 		return fmt.Errorf("can't parse json into %T: %w", out, err)
 	}
 	return nil
 }
 
-func (b *BeaconClient) GetSpec() (*ModelSpecData, error) {
+// getSSZ fetches raw ssz encoded object together with its fork version from the Eth-Consensus-Version header
+func (b *BeaconClient) getSSZ(ctx context.Context, url string) (forks.Version, []byte, error) {
+	res, err := b.request(ctx, url, true)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer res.Body.Close()
+
+	version, err := forks.ParseVersion(res.Header.Get("Eth-Consensus-Version"))
+	if err != nil {
+		return 0, nil, fmt.Errorf("can't parse consensus version header: %w", err)
+	}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("can't read ssz: %w", err)
+	}
+	return version, data, nil
+}
+
+func (b *BeaconClient) GetSpec(ctx context.Context) (*ModelSpecData, error) {
 	data := new(ModelSpec)
-	err := b.get("/eth/v1/config/spec", data, false)
+	err := b.get(ctx, "/eth/v1/config/spec", data)
 	if err != nil {
 		return nil, fmt.Errorf("can't fetch spec: %w", err)
 	}
 	return &data.Data, err
 }
 
-func (b *BeaconClient) GetGenesis() (*ModelGenesisData, error) {
+func (b *BeaconClient) GetGenesis(ctx context.Context) (*ModelGenesisData, error) {
 	data := new(ModelGenesis)
-	err := b.get("/eth/v1/beacon/genesis", data, false)
+	err := b.get(ctx, "/eth/v1/beacon/genesis", data)
 	if err != nil {
 		return nil, fmt.Errorf("can't fetch genesis: %w", err)
 	}
 	return &data.Data, err
 }
 
-func (b *BeaconClient) GetBlock(id string) (*ethpb2.BeaconBlockBellatrix, error) {
+func (b *BeaconClient) GetBlock(ctx context.Context, id string) (*forks.BeaconBlock, error) {
 	url := fmt.Sprintf("/eth/v2/beacon/blocks/%s", id)
-	data := new(ethpb2.SignedBeaconBlockBellatrix)
-	err := b.get(url, data, true)
+	version, data, err := b.getSSZ(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("can't fetch block: %w", err)
 	}
-	return data.Block, nil
+	block, err := forks.DecodeSignedBeaconBlock(version, data)
+	if err != nil {
+		return nil, fmt.Errorf("can't decode block: %w", err)
+	}
+	return block, nil
+}
+
+func (b *BeaconClient) GetBlockRoot(ctx context.Context, id string) (common.Hash, error) {
+	url := fmt.Sprintf("/eth/v1/beacon/blocks/%s/root", id)
+	data := new(ModelBlockRoot)
+	err := b.get(ctx, url, data)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("can't fetch block root: %w", err)
+	}
+	return data.Data.Root, nil
 }
 
-func (b *BeaconClient) GetState(slot uint64) (*ethpb2.BeaconStateBellatrix, error) {
+func (b *BeaconClient) GetSyncing(ctx context.Context) (*ModelSyncingData, error) {
+	data := new(ModelSyncing)
+	err := b.get(ctx, "/eth/v1/node/syncing", data)
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch sync status: %w", err)
+	}
+	return &data.Data, nil
+}
+
+func (b *BeaconClient) GetState(ctx context.Context, slot uint64) (*forks.BeaconState, error) {
 	url := fmt.Sprintf("/eth/v2/debug/beacon/states/%d", slot)
-	data := new(ethpb2.BeaconStateBellatrix)
-	err := b.get(url, data, true)
+	version, data, err := b.getSSZ(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("can't fetch block state: %w", err)
 	}
-	return data, nil
+	state, err := forks.DecodeBeaconState(version, data)
+	if err != nil {
+		return nil, fmt.Errorf("can't decode block state: %w", err)
+	}
+	return state, nil
+}
+
+func (b *BeaconClient) GetFinalityCheckpoints(ctx context.Context, slot uint64) (*ModelFinalityCheckpointsData, error) {
+	url := fmt.Sprintf("/eth/v1/beacon/states/%d/finality_checkpoints", slot)
+	data := new(ModelFinalityCheckpoints)
+	err := b.get(ctx, url, data)
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch finality checkpoints: %w", err)
+	}
+	return &data.Data, nil
+}
+
+func (b *BeaconClient) GetLightClientBootstrap(ctx context.Context, blockRoot common.Hash) (*ModelLightClientBootstrapData, error) {
+	url := fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%s", blockRoot)
+	data := new(ModelLightClientBootstrap)
+	err := b.get(ctx, url, data)
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch light client bootstrap: %w", err)
+	}
+	return &data.Data, nil
+}
+
+func (b *BeaconClient) GetLightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*ModelLightClientUpdateData, error) {
+	url := fmt.Sprintf("/eth/v1/beacon/light_client/updates?start_period=%d&count=%d", startPeriod, count)
+	var data []ModelLightClientUpdate
+	err := b.get(ctx, url, &data)
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch light client updates: %w", err)
+	}
+	res := make([]*ModelLightClientUpdateData, len(data))
+	for i := range data {
+		res[i] = &data[i].Data
+	}
+	return res, nil
+}
+
+func (b *BeaconClient) GetLightClientFinalityUpdate(ctx context.Context) (*ModelLightClientUpdateData, error) {
+	data := new(ModelLightClientUpdate)
+	err := b.get(ctx, "/eth/v1/beacon/light_client/finality_update", data)
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch light client finality update: %w", err)
+	}
+	return &data.Data, nil
+}
+
+func (b *BeaconClient) GetLightClientOptimisticUpdate(ctx context.Context) (*ModelLightClientUpdateData, error) {
+	data := new(ModelLightClientUpdate)
+	err := b.get(ctx, "/eth/v1/beacon/light_client/optimistic_update", data)
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch light client optimistic update: %w", err)
+	}
+	return &data.Data, nil
 }
@@ -0,0 +1,83 @@
+package beaconclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/config"
+)
+
+func TestBeaconClientErrors(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/eth/v1/beacon/blocks/1/root":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":404,"message":"block not found"}`)
+		case "/eth/v1/beacon/blocks/2/root":
+			w.WriteHeader(http.StatusBadRequest)
+		case "/eth/v1/beacon/blocks/3/root":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/eth/v1/beacon/blocks/4/root":
+			fmt.Fprint(w, `{"execution_optimistic":true,"data":{"root":"0x01"}}`)
+		case "/eth/v1/beacon/blocks/5/root":
+			if calls < 3 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			fmt.Fprintf(w, `{"data":{"root":"%s"}}`, common.Hash{5})
+		case "/eth/v2/beacon/blocks/6":
+			w.Header().Set("Eth-Execution-Optimistic", "true")
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(srv.URL, config.HTTPClientConfig{Retries: 2, RetryDelay: time.Millisecond})
+
+	_, err := c.GetBlockRoot(ctx, "1")
+	assert.ErrorIs(t, err, NotFoundError)
+	assert.Contains(t, err.Error(), "block not found")
+	_, err = c.GetBlockRoot(ctx, "2")
+	assert.ErrorIs(t, err, BadRequestError)
+	assert.NotErrorIs(t, err, NotFoundError)
+	_, err = c.GetBlockRoot(ctx, "3")
+	assert.ErrorIs(t, err, SyncingError)
+	_, err = c.GetBlockRoot(ctx, "4")
+	assert.ErrorIs(t, err, ExecutionOptimisticError)
+	assert.Equal(t, 4, calls)
+
+	calls = 0
+	root, err := c.GetBlockRoot(ctx, "5")
+	require.NoError(t, err)
+	assert.Equal(t, common.Hash{5}, root)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	c = NewClient(srv.URL, config.HTTPClientConfig{Retries: 1, RetryDelay: time.Millisecond})
+	_, err = c.GetBlockRoot(ctx, "5")
+	assert.Error(t, err)
+	assert.Equal(t, 2, calls)
+
+	// typed errors are not retried against the same node
+	calls = 0
+	_, err = c.GetBlock(ctx, "6")
+	assert.ErrorIs(t, err, ExecutionOptimisticError)
+	assert.Equal(t, 1, calls)
+	assert.False(t, isRetryable(fmt.Errorf("can't fetch: %w", InvalidDataError)))
+	assert.False(t, isRetryable(fmt.Errorf("can't fetch: %w", context.DeadlineExceeded)))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = c.GetBlockRoot(cancelled, "5")
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,64 @@
+package beaconclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	NotFoundError            = errors.New("not found")
+	BadRequestError          = errors.New("bad request")
+	SyncingError             = errors.New("beacon node is syncing")
+	ExecutionOptimisticError = errors.New("response is execution optimistic")
+	// InvalidDataError is returned by callers, that found malformed data in a successful response, e.g. public keys out of the curve
+	InvalidDataError = errors.New("invalid beacon node data")
+)
+
+// APIError is returned for unsuccessful beacon API responses.
+// It wraps one of the typed errors above, when the status code has a dedicated meaning.
+type APIError struct {
+	StatusCode int
+	Message    string
+	kind       error
+}
+
+func newAPIError(statusCode int, message string) *APIError {
+	err := &APIError{StatusCode: statusCode, Message: message}
+	switch statusCode {
+	case http.StatusNotFound:
+		err.kind = NotFoundError
+	case http.StatusBadRequest:
+		err.kind = BadRequestError
+	case http.StatusServiceUnavailable:
+		err.kind = SyncingError
+	}
+	return err
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("got error status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("got error status code: %d, %s", e.StatusCode, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.kind
+}
+
+// isRetryable tells whether the failed request can succeed when repeated against the same node.
+// Typed errors are returned right away, so that callers can act on them, e.g. fail over to another node.
+func isRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.kind == nil && (apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500)
+	}
+	for _, typed := range []error{ExecutionOptimisticError, SyncingError, InvalidDataError, context.Canceled, context.DeadlineExceeded} {
+		if errors.Is(err, typed) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,194 @@
+package beaconclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TopicFinalizedCheckpoint       = "finalized_checkpoint"
+	TopicLightClientFinalityUpdate = "light_client_finality_update"
+	// TopicConnected is not sent by the beacon node, it is emitted by EventStream after every (re)connection,
+	// so that consumers can catch up with the events missed while the stream was down
+	TopicConnected = "connected"
+	// TopicDisconnected is emitted by EventStream after the established connection is interrupted,
+	// so that consumers can fall back to polling until the stream is reconnected
+	TopicDisconnected = "disconnected"
+)
+
+const maxEventSize = 16 * 1024 * 1024
+
+// minRetryDelay bounds the reconnection delay requested by the server, so that retry: 0 doesn't cause a reconnection loop
+const minRetryDelay = 100 * time.Millisecond
+
+type Event struct {
+	Topic string
+	ID    string
+	Data  json.RawMessage
+}
+
+func (e *Event) DecodeFinalizedCheckpoint() (*ModelFinalizedCheckpointEvent, error) {
+	data := new(ModelFinalizedCheckpointEvent)
+	if err := json.Unmarshal(e.Data, data); err != nil {
+		return nil, fmt.Errorf("can't parse finalized checkpoint event: %w", err)
+	}
+	return data, nil
+}
+
+func (e *Event) DecodeLightClientFinalityUpdate() (*ModelLightClientUpdateData, error) {
+	data := new(ModelLightClientUpdate)
+	if err := json.Unmarshal(e.Data, data); err != nil {
+		return nil, fmt.Errorf("can't parse light client finality update event: %w", err)
+	}
+	return &data.Data, nil
+}
+
+// EventStream is a subscription to the server-sent events from /eth/v1/events endpoint.
+// Dropped connections are re-established with exponential backoff, passing the last received event id
+// in the Last-Event-ID header, so that nodes supporting it may replay missed events.
+// When several beacon nodes are given, the next one is tried after each failed connection.
+type EventStream struct {
+	baseUrls []string
+	current  int
+	topics   []string
+	c        *http.Client
+
+	lastEventID string
+	minDelay    time.Duration
+	maxDelay    time.Duration
+}
+
+func NewEventStream(baseUrls []string, topics ...string) *EventStream {
+	return &EventStream{
+		baseUrls: baseUrls,
+		topics:   topics,
+		// stream is long-lived, so no overall timeout is set
+		c:        &http.Client{Transport: http.DefaultTransport},
+		minDelay: time.Second,
+		maxDelay: time.Minute,
+	}
+}
+
+// Run reads events into the given channel until the context is cancelled
+func (s *EventStream) Run(ctx context.Context, events chan<- *Event) error {
+	delay := s.minDelay
+	for {
+		connected, err := s.stream(ctx, events)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if connected {
+			delay = s.minDelay
+			if !s.send(ctx, events, &Event{Topic: TopicDisconnected}) {
+				return ctx.Err()
+			}
+		} else {
+			s.current = (s.current + 1) % len(s.baseUrls)
+		}
+		log.Printf("Event stream is interrupted, reconnecting in %s: %s\n", delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if delay *= 2; delay > s.maxDelay {
+			delay = s.maxDelay
+		}
+	}
+}
+
+// stream reads events from a single connection, reporting whether the connection was established
+func (s *EventStream) stream(ctx context.Context, events chan<- *Event) (bool, error) {
+	url := fmt.Sprintf("%s/eth/v1/events?topics=%s", s.baseUrls[s.current], strings.Join(s.topics, ","))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return false, fmt.Errorf("can't make request from url: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if s.lastEventID != "" {
+		req.Header.Set("Last-Event-ID", s.lastEventID)
+	}
+	res, err := s.c.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("can't fetch from url: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("got error status code: %d", res.StatusCode)
+	}
+
+	if !s.send(ctx, events, &Event{Topic: TopicConnected}) {
+		return true, ctx.Err()
+	}
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 64*1024), maxEventSize)
+	event := new(Event)
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			// empty line dispatches the accumulated event
+			if len(data) > 0 {
+				event.Data = json.RawMessage(strings.Join(data, "\n"))
+				if event.ID != "" {
+					s.lastEventID = event.ID
+				}
+				if !s.send(ctx, events, event) {
+					return true, ctx.Err()
+				}
+			}
+			event, data = new(Event), nil
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			// comment, used by some nodes as a keep-alive
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event.Topic = value
+		case "data":
+			data = append(data, value)
+		case "id":
+			event.ID = value
+		case "retry":
+			if ms, err2 := strconv.ParseUint(value, 10, 64); err2 == nil {
+				s.minDelay = retryDelay(ms, s.maxDelay)
+			}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return true, fmt.Errorf("can't read event stream: %w", err)
+	}
+	return true, fmt.Errorf("event stream is closed by the server")
+}
+
+// retryDelay converts the retry field of the server in milliseconds into the reconnection delay between minRetryDelay and maxDelay
+func retryDelay(ms uint64, maxDelay time.Duration) time.Duration {
+	if ms > uint64(maxDelay/time.Millisecond) {
+		return maxDelay
+	}
+	if delay := time.Duration(ms) * time.Millisecond; delay > minRetryDelay {
+		return delay
+	}
+	return minRetryDelay
+}
+
+func (s *EventStream) send(ctx context.Context, events chan<- *Event, event *Event) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,96 @@
+package beaconclient
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStream(t *testing.T) {
+	var mu sync.Mutex
+	var lastEventIDs []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/events", r.URL.Path)
+		assert.Equal(t, "finalized_checkpoint,light_client_finality_update", r.URL.Query().Get("topics"))
+		mu.Lock()
+		lastEventIDs = append(lastEventIDs, r.Header.Get("Last-Event-ID"))
+		n := len(lastEventIDs)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, ": keep-alive\n\n")
+		fmt.Fprintf(w, "event: finalized_checkpoint\nid: %d\ndata: {\"epoch\":\"%d\",\n", n, n)
+		fmt.Fprintf(w, "data: \"block\":\"0x%064x\"}\n\n", n)
+		fmt.Fprintf(w, "event: light_client_finality_update\ndata: {\"data\":{\"signature_slot\":\"%d\"}}\n\n", n)
+	}))
+	defer srv.Close()
+
+	stream := NewEventStream([]string{srv.URL}, TopicFinalizedCheckpoint, TopicLightClientFinalityUpdate)
+	stream.minDelay = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *Event)
+	done := make(chan error)
+	go func() {
+		done <- stream.Run(ctx, events)
+	}()
+
+	for i := uint64(1); i <= 2; i++ {
+		event := <-events
+		assert.Equal(t, TopicConnected, event.Topic)
+
+		event = <-events
+		assert.Equal(t, TopicFinalizedCheckpoint, event.Topic)
+		checkpoint, err := event.DecodeFinalizedCheckpoint()
+		require.NoError(t, err)
+		assert.Equal(t, i, checkpoint.Epoch)
+		assert.Equal(t, common.BigToHash(new(big.Int).SetUint64(i)), checkpoint.Block)
+
+		event = <-events
+		assert.Equal(t, TopicLightClientFinalityUpdate, event.Topic)
+		update, err := event.DecodeLightClientFinalityUpdate()
+		require.NoError(t, err)
+		assert.Equal(t, i, update.SignatureSlot)
+
+		// the server closes the stream after the events
+		event = <-events
+		assert.Equal(t, TopicDisconnected, event.Topic)
+	}
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"", "1"}, lastEventIDs[:2])
+}
+
+func TestEventStreamRetry(t *testing.T) {
+	for _, tt := range []struct {
+		retry    string
+		expected time.Duration
+	}{
+		{"0", minRetryDelay},
+		{"50", minRetryDelay},
+		{"5000", 5 * time.Second},
+		{"99999999999999999", time.Minute},
+		{"abc", time.Second},
+	} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, "retry: %s\n\n", tt.retry)
+		}))
+		stream := NewEventStream([]string{srv.URL}, TopicFinalizedCheckpoint)
+		connected, err := stream.stream(context.Background(), make(chan *Event, 1))
+		srv.Close()
+		assert.True(t, connected)
+		assert.Error(t, err)
+		assert.Equal(t, tt.expected, stream.minDelay, "retry: %s", tt.retry)
+	}
+}
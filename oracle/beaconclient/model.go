@@ -1,5 +1,10 @@
 package beaconclient
 
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
 type ModelGenesis struct {
 	Data ModelGenesisData `json:"data"`
 }
@@ -9,21 +14,138 @@ type ModelGenesisData struct {
 	GenesisValidatorsRoot string `json:"genesis_validators_root"`
 }
 
+type ModelError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ModelResponseMeta holds metadata fields, shared by many beacon API responses
+type ModelResponseMeta struct {
+	ExecutionOptimistic bool `json:"execution_optimistic"`
+	Finalized           bool `json:"finalized"`
+}
+
 type ModelSpec struct {
 	Data ModelSpecData `json:"data"`
 }
 
 type ModelSpecData struct {
-	SecondsPerSlot               uint64 `json:"SECONDS_PER_SLOT,string"`
-	SlotsPerEpoch                uint64 `json:"SLOTS_PER_EPOCH,string"`
-	AltairForkEpoch              uint64 `json:"ALTAIR_FORK_EPOCH,string"`
-	AltairForkVersion            string `json:"ALTAIR_FORK_VERSION"`
-	BellatrixForkEpoch           uint64 `json:"BELLATRIX_FORK_EPOCH,string"`
-	BellatrixForkVersion         string `json:"BELLATRIX_FORK_VERSION"`
-	EpochsPerSyncCommitteePeriod uint64 `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD,string"`
-	SyncCommitteeSize            int    `json:"SYNC_COMMITTEE_SIZE,string"`
-	ValidatorRegistryLimit       int    `json:"VALIDATOR_REGISTRY_LIMIT,string"`
-	HistoricalRootsLimit         int    `json:"HISTORICAL_ROOTS_LIMIT,string"`
-	EpochsPerEth1VotingPeriod    uint64 `json:"EPOCHS_PER_ETH1_VOTING_PERIOD,string"`
-	SlotsPerHistoricalRoot       uint64 `json:"SLOTS_PER_HISTORICAL_ROOT,string"`
+	SecondsPerSlot                 uint64 `json:"SECONDS_PER_SLOT,string"`
+	SlotsPerEpoch                  uint64 `json:"SLOTS_PER_EPOCH,string"`
+	GenesisForkVersion             string `json:"GENESIS_FORK_VERSION"`
+	AltairForkEpoch                uint64 `json:"ALTAIR_FORK_EPOCH,string"`
+	AltairForkVersion              string `json:"ALTAIR_FORK_VERSION"`
+	BellatrixForkEpoch             uint64 `json:"BELLATRIX_FORK_EPOCH,string"`
+	BellatrixForkVersion           string `json:"BELLATRIX_FORK_VERSION"`
+	CapellaForkEpoch               uint64 `json:"CAPELLA_FORK_EPOCH,string"`
+	CapellaForkVersion             string `json:"CAPELLA_FORK_VERSION"`
+	DenebForkEpoch                 uint64 `json:"DENEB_FORK_EPOCH,string"`
+	DenebForkVersion               string `json:"DENEB_FORK_VERSION"`
+	ElectraForkEpoch               uint64 `json:"ELECTRA_FORK_EPOCH,string"`
+	ElectraForkVersion             string `json:"ELECTRA_FORK_VERSION"`
+	FuluForkEpoch                  uint64 `json:"FULU_FORK_EPOCH,string"`
+	FuluForkVersion                string `json:"FULU_FORK_VERSION"`
+	EpochsPerSyncCommitteePeriod   uint64 `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD,string"`
+	SyncCommitteeSize              int    `json:"SYNC_COMMITTEE_SIZE,string"`
+	ValidatorRegistryLimit         int    `json:"VALIDATOR_REGISTRY_LIMIT,string"`
+	HistoricalRootsLimit           int    `json:"HISTORICAL_ROOTS_LIMIT,string"`
+	EpochsPerEth1VotingPeriod      uint64 `json:"EPOCHS_PER_ETH1_VOTING_PERIOD,string"`
+	SlotsPerHistoricalRoot         uint64 `json:"SLOTS_PER_HISTORICAL_ROOT,string"`
+	PendingDepositsLimit           int    `json:"PENDING_DEPOSITS_LIMIT,string"`
+	PendingPartialWithdrawalsLimit int    `json:"PENDING_PARTIAL_WITHDRAWALS_LIMIT,string"`
+	PendingConsolidationsLimit     int    `json:"PENDING_CONSOLIDATIONS_LIMIT,string"`
+}
+
+type ModelBeaconBlockHeader struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Hash `json:"parent_root"`
+	StateRoot     common.Hash `json:"state_root"`
+	BodyRoot      common.Hash `json:"body_root"`
+}
+
+type ModelLightClientHeader struct {
+	Beacon ModelBeaconBlockHeader `json:"beacon"`
+}
+
+type ModelSyncCommittee struct {
+	Pubkeys         []hexutil.Bytes `json:"pubkeys"`
+	AggregatePubkey hexutil.Bytes   `json:"aggregate_pubkey"`
+}
+
+type ModelSyncAggregate struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"`
+}
+
+type ModelLightClientBootstrap struct {
+	Version string                        `json:"version"`
+	Data    ModelLightClientBootstrapData `json:"data"`
+}
+
+type ModelLightClientBootstrapData struct {
+	Header                     ModelLightClientHeader `json:"header"`
+	CurrentSyncCommittee       ModelSyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []common.Hash          `json:"current_sync_committee_branch"`
+}
+
+// ModelLightClientUpdate is shared by updates, finality_update and optimistic_update endpoints,
+// fields that are not present in the particular response are left empty.
+type ModelLightClientUpdate struct {
+	Version string                     `json:"version"`
+	Data    ModelLightClientUpdateData `json:"data"`
+}
+
+type ModelLightClientUpdateData struct {
+	AttestedHeader          ModelLightClientHeader  `json:"attested_header"`
+	NextSyncCommittee       *ModelSyncCommittee     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []common.Hash           `json:"next_sync_committee_branch"`
+	FinalizedHeader         *ModelLightClientHeader `json:"finalized_header"`
+	FinalityBranch          []common.Hash           `json:"finality_branch"`
+	SyncAggregate           ModelSyncAggregate      `json:"sync_aggregate"`
+	SignatureSlot           uint64                  `json:"signature_slot,string"`
+}
+
+type ModelFinalizedCheckpointEvent struct {
+	Block               common.Hash `json:"block"`
+	State               common.Hash `json:"state"`
+	Epoch               uint64      `json:"epoch,string"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+type ModelCheckpoint struct {
+	Epoch uint64      `json:"epoch,string"`
+	Root  common.Hash `json:"root"`
+}
+
+type ModelFinalityCheckpoints struct {
+	Data ModelFinalityCheckpointsData `json:"data"`
+}
+
+type ModelFinalityCheckpointsData struct {
+	PreviousJustified ModelCheckpoint `json:"previous_justified"`
+	CurrentJustified  ModelCheckpoint `json:"current_justified"`
+	Finalized         ModelCheckpoint `json:"finalized"`
+}
+
+type ModelBlockRoot struct {
+	Data struct {
+		Root common.Hash `json:"root"`
+	} `json:"data"`
+}
+
+type ModelSyncing struct {
+	Data ModelSyncingData `json:"data"`
+}
+
+type ModelSyncingData struct {
+	HeadSlot     uint64 `json:"head_slot,string"`
+	SyncDistance uint64 `json:"sync_distance,string"`
+	IsSyncing    bool   `json:"is_syncing"`
+	IsOptimistic bool   `json:"is_optimistic"`
+	ElOffline    bool   `json:"el_offline"`
+}
+
+func (s *ModelSyncingData) IsSynced() bool {
+	return !s.IsSyncing && !s.IsOptimistic && !s.ElOffline
 }
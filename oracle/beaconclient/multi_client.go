@@ -0,0 +1,303 @@
+package beaconclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"oracle/forks"
+)
+
+const (
+	syncCheckInterval = 30 * time.Second
+	syncCheckTimeout  = 5 * time.Second
+	// maxTrackedResponses is the number of the latest responses, for which the serving node is remembered
+	maxTrackedResponses = 256
+)
+
+var (
+	_ Eth2Client          = (*MultiClient)(nil)
+	_ InvalidDataReporter = (*MultiClient)(nil)
+)
+
+// MultiClient is an Eth2Client backed by several beacon nodes.
+// Requests are sent to synced nodes first, falling back to the other nodes on errors.
+// Blocks can be additionally cross-checked, requiring the block root to be confirmed by the quorum of nodes.
+type MultiClient struct {
+	nodes  []*node
+	quorum int
+	mu     sync.Mutex
+	// sources maps the latest responses to the nodes, that served them, so that invalid data is blamed on the right node
+	sources    map[interface{}]*node
+	sourceKeys []interface{}
+}
+
+// blockResponse and stateResponse identify blocks and states by slot, so that tracking doesn't retain large objects
+type (
+	blockResponse uint64
+	stateResponse uint64
+)
+
+type node struct {
+	index     int
+	client    Eth2Client
+	synced    bool
+	checkedAt time.Time
+	failures  int
+}
+
+// NewMultiClient creates a client for the given beacon nodes, quorum <= 1 disables block root cross-checking
+func NewMultiClient(quorum int, clients ...Eth2Client) *MultiClient {
+	m := &MultiClient{quorum: quorum, sources: make(map[interface{}]*node)}
+	for i, c := range clients {
+		m.nodes = append(m.nodes, &node{index: i, client: c, synced: true})
+	}
+	return m
+}
+
+// candidates returns nodes in the order they should be queried:
+// synced nodes go first, nodes with fewer recent failures go before the others
+func (m *MultiClient) candidates(ctx context.Context) []*node {
+	m.mu.Lock()
+	var stale []*node
+	for _, n := range m.nodes {
+		if _, ok := n.client.(SyncStatusReporter); ok && time.Since(n.checkedAt) >= syncCheckInterval {
+			// concurrent requests don't check the node again, while its check is in progress
+			n.checkedAt = time.Now()
+			stale = append(stale, n)
+		}
+	}
+	m.mu.Unlock()
+
+	// sync status is requested without holding the lock and with a short timeout,
+	// so that a hung node doesn't block concurrent requests
+	var wg sync.WaitGroup
+	for _, n := range stale {
+		wg.Add(1)
+		go func(n *node) {
+			defer wg.Done()
+			m.checkSync(ctx, n)
+		}(n)
+	}
+	wg.Wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := append([]*node{}, m.nodes...)
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].synced != res[j].synced {
+			return res[i].synced
+		}
+		return res[i].failures < res[j].failures
+	})
+	return res
+}
+
+func (m *MultiClient) checkSync(ctx context.Context, n *node) {
+	checkCtx, cancel := context.WithTimeout(ctx, syncCheckTimeout)
+	defer cancel()
+	status, err := n.client.(SyncStatusReporter).GetSyncing(checkCtx)
+	if err != nil && ctx.Err() != nil {
+		// the request was cancelled, it tells nothing about the node
+		return
+	}
+	synced := err == nil && status.IsSynced()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n.synced && !synced {
+		log.Printf("Beacon node #%d is not synced, deprioritizing it\n", n.index)
+	}
+	n.synced = synced
+}
+
+func (m *MultiClient) report(n *node, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err != nil {
+		n.failures++
+	} else {
+		n.failures = 0
+	}
+}
+
+// responseKey identifies the response for ReportInvalidData, nil is returned for responses that are not tracked
+func responseKey(res interface{}) interface{} {
+	switch r := res.(type) {
+	case *forks.BeaconBlock:
+		return blockResponse(r.Slot)
+	case *forks.BeaconState:
+		return stateResponse(r.Slot)
+	case *ModelLightClientBootstrapData, *ModelLightClientUpdateData:
+		return res
+	}
+	return nil
+}
+
+// track remembers the node, that served the successful response
+func (m *MultiClient) track(res interface{}, n *node) {
+	if updates, ok := res.([]*ModelLightClientUpdateData); ok {
+		for _, update := range updates {
+			m.track(update, n)
+		}
+		return
+	}
+	key := responseKey(res)
+	if key == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.sources[key]; !ok {
+		if len(m.sourceKeys) >= maxTrackedResponses {
+			delete(m.sources, m.sourceKeys[0])
+			m.sourceKeys = m.sourceKeys[1:]
+		}
+		m.sourceKeys = append(m.sourceKeys, key)
+	}
+	m.sources[key] = n
+}
+
+// ReportInvalidData deprioritizes the node, that served the given response.
+// Blocks and states are matched by slot, other responses by identity, only the latest responses are remembered.
+func (m *MultiClient) ReportInvalidData(response interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.sources[responseKey(response)]
+	if !ok {
+		log.Printf("Can't find beacon node, that returned invalid %T\n", response)
+		return
+	}
+	n.failures++
+	log.Printf("Beacon node #%d returned invalid data, deprioritizing it\n", n.index)
+}
+
+// call returns the first successful response, trying nodes in the order of their preference.
+// NotFoundError and BadRequestError are specific to the request, so they are returned without trying other nodes.
+func call[T any](ctx context.Context, m *MultiClient, f func(c Eth2Client) (T, error)) (T, error) {
+	var lastErr error
+	for _, n := range m.candidates(ctx) {
+		res, err := f(n.client)
+		if err == nil || errors.Is(err, NotFoundError) || errors.Is(err, BadRequestError) {
+			m.report(n, nil)
+			if err == nil {
+				m.track(res, n)
+			}
+			return res, err
+		}
+		if ctx.Err() != nil {
+			return res, ctx.Err()
+		}
+		m.report(n, err)
+		log.Printf("Request to beacon node #%d failed: %s\n", n.index, err)
+		lastErr = err
+	}
+	var res T
+	return res, fmt.Errorf("all %d beacon nodes failed, last error: %w", len(m.nodes), lastErr)
+}
+
+func (m *MultiClient) GetSpec(ctx context.Context) (*ModelSpecData, error) {
+	return call(ctx, m, func(c Eth2Client) (*ModelSpecData, error) {
+		return c.GetSpec(ctx)
+	})
+}
+
+func (m *MultiClient) GetGenesis(ctx context.Context) (*ModelGenesisData, error) {
+	return call(ctx, m, func(c Eth2Client) (*ModelGenesisData, error) {
+		return c.GetGenesis(ctx)
+	})
+}
+
+func (m *MultiClient) GetBlock(ctx context.Context, id string) (*forks.BeaconBlock, error) {
+	var source Eth2Client
+	block, err := call(ctx, m, func(c Eth2Client) (*forks.BeaconBlock, error) {
+		source = c
+		return c.GetBlock(ctx, id)
+	})
+	if err != nil || m.quorum <= 1 {
+		return block, err
+	}
+
+	root := block.Root()
+	// relative ids like head or finalized might legitimately point to different blocks on different nodes,
+	// so the block is checked to be canonical at its slot instead
+	checkID := strconv.FormatUint(block.Slot, 10)
+	if strings.HasPrefix(id, "0x") {
+		checkID = root.String()
+	}
+	confirmations := 1
+	for _, n := range m.candidates(ctx) {
+		if n.client == source {
+			continue
+		}
+		if confirmations >= m.quorum {
+			break
+		}
+		otherRoot, err2 := n.client.GetBlockRoot(ctx, checkID)
+		if err2 != nil {
+			log.Printf("Can't get block root %s from beacon node #%d: %s\n", checkID, n.index, err2)
+			continue
+		}
+		if otherRoot == root {
+			confirmations++
+		} else {
+			log.Printf("Beacon node #%d has a different block root at %s: %s != %s\n", n.index, checkID, otherRoot, root)
+		}
+	}
+	if confirmations < m.quorum {
+		return nil, fmt.Errorf("block %s with root %s is confirmed by %d beacon nodes, %d required", id, root, confirmations, m.quorum)
+	}
+	return block, nil
+}
+
+func (m *MultiClient) GetBlockRoot(ctx context.Context, id string) (common.Hash, error) {
+	return call(ctx, m, func(c Eth2Client) (common.Hash, error) {
+		return c.GetBlockRoot(ctx, id)
+	})
+}
+
+func (m *MultiClient) GetState(ctx context.Context, slot uint64) (*forks.BeaconState, error) {
+	return call(ctx, m, func(c Eth2Client) (*forks.BeaconState, error) {
+		return c.GetState(ctx, slot)
+	})
+}
+
+func (m *MultiClient) GetFinalityCheckpoints(ctx context.Context, slot uint64) (*ModelFinalityCheckpointsData, error) {
+	return call(ctx, m, func(c Eth2Client) (*ModelFinalityCheckpointsData, error) {
+		return c.GetFinalityCheckpoints(ctx, slot)
+	})
+}
+
+func (m *MultiClient) GetLightClientBootstrap(ctx context.Context, blockRoot common.Hash) (*ModelLightClientBootstrapData, error) {
+	return call(ctx, m, func(c Eth2Client) (*ModelLightClientBootstrapData, error) {
+		return c.GetLightClientBootstrap(ctx, blockRoot)
+	})
+}
+
+func (m *MultiClient) GetLightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*ModelLightClientUpdateData, error) {
+	return call(ctx, m, func(c Eth2Client) ([]*ModelLightClientUpdateData, error) {
+		return c.GetLightClientUpdates(ctx, startPeriod, count)
+	})
+}
+
+func (m *MultiClient) GetLightClientFinalityUpdate(ctx context.Context) (*ModelLightClientUpdateData, error) {
+	return call(ctx, m, func(c Eth2Client) (*ModelLightClientUpdateData, error) {
+		return c.GetLightClientFinalityUpdate(ctx)
+	})
+}
+
+func (m *MultiClient) GetLightClientOptimisticUpdate(ctx context.Context) (*ModelLightClientUpdateData, error) {
+	return call(ctx, m, func(c Eth2Client) (*ModelLightClientUpdateData, error) {
+		return c.GetLightClientOptimisticUpdate(ctx)
+	})
+}
@@ -0,0 +1,139 @@
+package beaconclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/forks"
+)
+
+type testClient struct {
+	Eth2Client
+	synced bool
+	err    error
+	block  *forks.BeaconBlock
+	calls  int
+}
+
+func (c *testClient) GetSyncing(ctx context.Context) (*ModelSyncingData, error) {
+	return &ModelSyncingData{IsSyncing: !c.synced}, nil
+}
+
+func (c *testClient) GetBlock(ctx context.Context, id string) (*forks.BeaconBlock, error) {
+	c.calls++
+	return c.block, c.err
+}
+
+func (c *testClient) GetBlockRoot(ctx context.Context, id string) (common.Hash, error) {
+	if c.err != nil {
+		return common.Hash{}, c.err
+	}
+	return c.block.Root(), nil
+}
+
+func TestMultiClientFailover(t *testing.T) {
+	block := &forks.BeaconBlock{Slot: 10}
+	syncing := &testClient{block: block}
+	failing := &testClient{synced: true, err: errors.New("connection refused")}
+	healthy := &testClient{synced: true, block: block}
+	m := NewMultiClient(0, syncing, failing, healthy)
+
+	res, err := m.GetBlock(context.Background(), "10")
+	require.NoError(t, err)
+	assert.Equal(t, block, res)
+	assert.Equal(t, []int{0, 1, 1}, []int{syncing.calls, failing.calls, healthy.calls})
+
+	// failed node is deprioritized
+	_, err = m.GetBlock(context.Background(), "10")
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, []int{syncing.calls, failing.calls, healthy.calls})
+
+	notFound := &testClient{synced: true, err: NotFoundError}
+	m = NewMultiClient(0, notFound, healthy)
+	_, err = m.GetBlock(context.Background(), "11")
+	assert.ErrorIs(t, err, NotFoundError)
+
+	m = NewMultiClient(0, failing)
+	_, err = m.GetBlock(context.Background(), "10")
+	assert.Error(t, err)
+}
+
+func TestMultiClientQuorum(t *testing.T) {
+	block := &forks.BeaconBlock{Slot: 10}
+	other := &forks.BeaconBlock{Slot: 10, ProposerIndex: 1}
+	a := &testClient{synced: true, block: block}
+	b := &testClient{synced: true, block: other}
+	c := &testClient{synced: true, block: block}
+
+	res, err := NewMultiClient(2, a, b, c).GetBlock(context.Background(), "10")
+	require.NoError(t, err)
+	assert.Equal(t, block, res)
+
+	_, err = NewMultiClient(3, a, b, c).GetBlock(context.Background(), "10")
+	assert.Error(t, err)
+
+	_, err = NewMultiClient(2, b, a).GetBlock(context.Background(), "head")
+	assert.Error(t, err)
+}
+
+func TestMultiClientReportInvalidData(t *testing.T) {
+	a := &testClient{synced: true, block: &forks.BeaconBlock{Slot: 10}}
+	b := &testClient{synced: true, block: &forks.BeaconBlock{Slot: 11}}
+	m := NewMultiClient(0, a, b)
+
+	// responses, that weren't served by the client, are ignored
+	m.ReportInvalidData(&forks.BeaconBlock{Slot: 10})
+
+	first, err := m.GetBlock(context.Background(), "10")
+	require.NoError(t, err)
+	a.err = errors.New("connection refused")
+	second, err := m.GetBlock(context.Background(), "11")
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, []int{m.nodes[0].failures, m.nodes[1].failures})
+
+	// the node, that served the response, is blamed, even if another node served the latest one
+	m.ReportInvalidData(first)
+	assert.Equal(t, []int{2, 0}, []int{m.nodes[0].failures, m.nodes[1].failures})
+	m.ReportInvalidData(second)
+	assert.Equal(t, []int{2, 1}, []int{m.nodes[0].failures, m.nodes[1].failures})
+}
+
+type hungSyncClient struct {
+	testClient
+	checking chan struct{}
+}
+
+func (c *hungSyncClient) GetSyncing(ctx context.Context) (*ModelSyncingData, error) {
+	close(c.checking)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestMultiClientHungSyncCheck(t *testing.T) {
+	block := &forks.BeaconBlock{Slot: 10}
+	hung := &hungSyncClient{testClient: testClient{block: block}, checking: make(chan struct{})}
+	healthy := &testClient{synced: true, block: block}
+	m := NewMultiClient(0, hung, healthy)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := m.GetBlock(ctx, "10")
+		done <- err
+	}()
+	<-hung.checking
+
+	// concurrent requests don't wait for the sync check in progress
+	m.ReportInvalidData(block)
+	res, err := m.GetBlock(context.Background(), "10")
+	require.NoError(t, err)
+	assert.Equal(t, block, res)
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,85 @@
+package beaconserver
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// jsonNames overrides field names, that can't be derived from the go field names
+var jsonNames = map[string]string{
+	"Attestation1": "attestation_1",
+	"Attestation2": "attestation_2",
+	"Header1":      "header_1",
+	"Header2":      "header_2",
+}
+
+// toJSON converts ssz containers into their beacon API json representation:
+// field names are in snake case, integers are decimal strings and byte arrays are 0x-prefixed hex strings
+func toJSON(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return toJSON(v.Elem())
+	case reflect.Struct:
+		res := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.Name == "BaseFeePerGas" {
+				// the only uint256 field, it is encoded as a little endian byte array in ssz
+				res[jsonName(field.Name)] = littleEndianToDecimal(v.Field(i).Bytes())
+				continue
+			}
+			res[jsonName(field.Name)] = toJSON(v.Field(i))
+		}
+		return res
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return hexutil.Encode(v.Bytes())
+		}
+		res := make([]interface{}, v.Len())
+		for i := range res {
+			res[i] = toJSON(v.Index(i))
+		}
+		return res
+	case reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		return v.Bool()
+	}
+	return v.Interface()
+}
+
+// jsonName converts CamelCase go field name into snake_case, keeping acronyms together, e.g. FromBLSPubkey -> from_bls_pubkey
+func jsonName(name string) string {
+	if res, ok := jsonNames[name]; ok {
+		return res
+	}
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+func littleEndianToDecimal(b []byte) string {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	return new(big.Int).SetBytes(be).String()
+}
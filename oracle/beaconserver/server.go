@@ -0,0 +1,203 @@
+// Package beaconserver serves a subset of the beacon node API from any beaconclient.Eth2Client,
+// so that the oracle binaries can run against recorded fixtures, caches or synthetic chains instead of a real node.
+package beaconserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"oracle/beaconclient"
+	"oracle/forks"
+)
+
+type Server struct {
+	client beaconclient.Eth2Client
+}
+
+func NewServer(client beaconclient.Eth2Client) *Server {
+	return &Server{client: client}
+}
+
+type versionedResponse struct {
+	Version             string      `json:"version"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+	Data                interface{} `json:"data"`
+}
+
+type dataResponse struct {
+	Data interface{} `json:"data"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, &beaconclient.ModelError{Code: http.StatusMethodNotAllowed, Message: "method not allowed"})
+		return
+	}
+	path := r.URL.Path
+	var err error
+	switch {
+	case path == "/eth/v1/config/spec":
+		err = s.serveSpec(w, r)
+	case path == "/eth/v1/beacon/genesis":
+		err = s.serveGenesis(w, r)
+	case path == "/eth/v1/node/syncing":
+		err = s.serveSyncing(w, r)
+	case strings.HasPrefix(path, "/eth/v1/beacon/blocks/") && strings.HasSuffix(path, "/root"):
+		err = s.serveBlockRoot(w, r, strings.TrimSuffix(strings.TrimPrefix(path, "/eth/v1/beacon/blocks/"), "/root"))
+	case strings.HasPrefix(path, "/eth/v2/beacon/blocks/"):
+		err = s.serveBlock(w, r, strings.TrimPrefix(path, "/eth/v2/beacon/blocks/"))
+	case strings.HasPrefix(path, "/eth/v1/beacon/states/") && strings.HasSuffix(path, "/finality_checkpoints"):
+		err = s.serveFinalityCheckpoints(w, r, strings.TrimSuffix(strings.TrimPrefix(path, "/eth/v1/beacon/states/"), "/finality_checkpoints"))
+	case strings.HasPrefix(path, "/eth/v2/debug/beacon/states/"):
+		err = s.serveState(w, r, strings.TrimPrefix(path, "/eth/v2/debug/beacon/states/"))
+	default:
+		err = fmt.Errorf("unknown endpoint %s: %w", path, beaconclient.NotFoundError)
+	}
+	if err != nil {
+		log.Printf("Request %s failed: %s\n", r.URL, err)
+		writeError(w, err)
+	}
+}
+
+func (s *Server) serveSpec(w http.ResponseWriter, r *http.Request) error {
+	spec, err := s.client.GetSpec(r.Context())
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, &dataResponse{Data: spec})
+	return nil
+}
+
+func (s *Server) serveGenesis(w http.ResponseWriter, r *http.Request) error {
+	genesis, err := s.client.GetGenesis(r.Context())
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, &dataResponse{Data: genesis})
+	return nil
+}
+
+// serveSyncing reports the status of the underlying node if it is known, otherwise the node is considered synced
+func (s *Server) serveSyncing(w http.ResponseWriter, r *http.Request) error {
+	if reporter, ok := s.client.(beaconclient.SyncStatusReporter); ok {
+		status, err := reporter.GetSyncing(r.Context())
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, &dataResponse{Data: status})
+		return nil
+	}
+	head, err := s.client.GetBlock(r.Context(), "head")
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, &dataResponse{Data: &beaconclient.ModelSyncingData{HeadSlot: head.Slot}})
+	return nil
+}
+
+func (s *Server) serveBlockRoot(w http.ResponseWriter, r *http.Request, id string) error {
+	root, err := s.client.GetBlockRoot(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	res := new(beaconclient.ModelBlockRoot)
+	res.Data.Root = root
+	writeJSON(w, http.StatusOK, res)
+	return nil
+}
+
+func (s *Server) serveBlock(w http.ResponseWriter, r *http.Request, id string) error {
+	block, err := s.client.GetBlock(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	return writeVersioned(w, r, block.Version, block.Signed)
+}
+
+// stateSlot supports slot numbers and the block ids as state ids, resolving the latter to the slot of the block
+func (s *Server) stateSlot(r *http.Request, id string) (uint64, error) {
+	slot, err := strconv.ParseUint(id, 10, 64)
+	if err == nil {
+		return slot, nil
+	}
+	if strings.HasPrefix(id, "0x") {
+		return 0, fmt.Errorf("states can't be requested by state root: %w", beaconclient.BadRequestError)
+	}
+	block, err := s.client.GetBlock(r.Context(), id)
+	if err != nil {
+		return 0, err
+	}
+	return block.Slot, nil
+}
+
+func (s *Server) serveState(w http.ResponseWriter, r *http.Request, id string) error {
+	slot, err := s.stateSlot(r, id)
+	if err != nil {
+		return err
+	}
+	state, err := s.client.GetState(r.Context(), slot)
+	if err != nil {
+		return err
+	}
+	return writeVersioned(w, r, state.Version, state.Raw)
+}
+
+func (s *Server) serveFinalityCheckpoints(w http.ResponseWriter, r *http.Request, id string) error {
+	slot, err := s.stateSlot(r, id)
+	if err != nil {
+		return err
+	}
+	checkpoints, err := s.client.GetFinalityCheckpoints(r.Context(), slot)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, &dataResponse{Data: checkpoints})
+	return nil
+}
+
+// writeVersioned writes the object as ssz if the client accepts it, or as json otherwise
+func writeVersioned(w http.ResponseWriter, r *http.Request, version forks.Version, obj forks.Object) error {
+	w.Header().Set("Eth-Consensus-Version", version.String())
+	if strings.Contains(r.Header.Get("Accept"), "application/octet-stream") {
+		data, err := obj.MarshalSSZ()
+		if err != nil {
+			return fmt.Errorf("can't marshal %T: %w", obj, err)
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+		return nil
+	}
+	writeJSON(w, http.StatusOK, &versionedResponse{
+		Version: version.String(),
+		Data:    toJSON(reflect.ValueOf(obj)),
+	})
+	return nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, beaconclient.NotFoundError):
+		code = http.StatusNotFound
+	case errors.Is(err, beaconclient.BadRequestError):
+		code = http.StatusBadRequest
+	case errors.Is(err, beaconclient.SyncingError):
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, &beaconclient.ModelError{Code: code, Message: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Can't write response: %s\n", err)
+	}
+}
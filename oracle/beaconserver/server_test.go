@@ -0,0 +1,124 @@
+package beaconserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/beaconclient"
+	"oracle/config"
+	"oracle/lightclient"
+	"oracle/testchain"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{
+		Missed: func(slot uint64) bool { return slot == 28 },
+	})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(29))
+	srv := httptest.NewServer(NewServer(chain))
+	defer srv.Close()
+	client := beaconclient.NewClient(srv.URL, config.HTTPClientConfig{})
+
+	spec, err := client.GetSpec(ctx)
+	require.NoError(t, err)
+	expectedSpec, _ := chain.GetSpec(ctx)
+	assert.Equal(t, expectedSpec, spec)
+
+	genesis, err := client.GetGenesis(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, chain.Genesis.GenesisValidatorsRoot.Hex(), genesis.GenesisValidatorsRoot)
+
+	head, err := client.GetBlock(ctx, "head")
+	require.NoError(t, err)
+	assert.Equal(t, chain.Head().Root(), head.Root())
+	root, err := client.GetBlockRoot(ctx, "finalized")
+	require.NoError(t, err)
+	expectedRoot, _ := chain.GetBlockRoot(ctx, "finalized")
+	assert.Equal(t, expectedRoot, root)
+
+	_, err = client.GetBlock(ctx, "28")
+	assert.ErrorIs(t, err, beaconclient.NotFoundError)
+	_, err = client.GetBlockRoot(ctx, "abc")
+	assert.ErrorIs(t, err, beaconclient.BadRequestError)
+
+	state, err := client.GetState(ctx, 28)
+	require.NoError(t, err)
+	expectedState, _ := chain.GetState(ctx, 28)
+	data, _ := state.Raw.MarshalSSZ()
+	expectedData, _ := expectedState.Raw.MarshalSSZ()
+	assert.Equal(t, expectedData, data)
+
+	checkpoints, err := client.GetFinalityCheckpoints(ctx, 28)
+	require.NoError(t, err)
+	assert.Equal(t, expectedState.FinalizedCheckpoint.Epoch, checkpoints.Finalized.Epoch)
+	assert.Equal(t, expectedState.FinalizedCheckpoint.Root, checkpoints.Finalized.Root.Bytes())
+	assert.NotEqual(t, common.Hash{}, checkpoints.Finalized.Root)
+
+	// light client works over http in the same way as with the chain itself
+	lc, err := lightclient.NewLightClient(ctx, config.Eth2Config{Client: config.HTTPClientConfig{URL: srv.URL}}, true)
+	require.NoError(t, err)
+	update, err := lc.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 27, update.AttestedHeader.Slot)
+}
+
+func TestServerJSON(t *testing.T) {
+	chain, err := testchain.NewChain(testchain.Config{})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(3))
+	srv := httptest.NewServer(NewServer(chain))
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/eth/v2/beacon/blocks/3")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, "bellatrix", res.Header.Get("Eth-Consensus-Version"))
+	var block struct {
+		Version string `json:"version"`
+		Data    struct {
+			Message struct {
+				Slot          string `json:"slot"`
+				ProposerIndex string `json:"proposer_index"`
+				Body          struct {
+					Eth1Data      map[string]string      `json:"eth1_data"`
+					SyncAggregate map[string]string      `json:"sync_aggregate"`
+					Payload       map[string]interface{} `json:"execution_payload"`
+				} `json:"body"`
+			} `json:"message"`
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&block))
+	assert.Equal(t, "bellatrix", block.Version)
+	assert.Equal(t, "3", block.Data.Message.Slot)
+	assert.Equal(t, "3", block.Data.Message.ProposerIndex)
+	assert.Equal(t, "640", block.Data.Message.Body.Eth1Data["deposit_count"])
+	assert.Len(t, block.Data.Message.Body.SyncAggregate["sync_committee_bits"], 2+128)
+	assert.Equal(t, "3", block.Data.Message.Body.Payload["block_number"])
+	assert.Equal(t, "0", block.Data.Message.Body.Payload["base_fee_per_gas"])
+	assert.Equal(t, []interface{}{}, block.Data.Message.Body.Payload["transactions"])
+	assert.Len(t, block.Data.Signature, 2+192)
+}
+
+func TestJSONName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"Slot":                  "slot",
+		"Eth1DepositIndex":      "eth1_deposit_index",
+		"BLSToExecutionChanges": "bls_to_execution_changes",
+		"FromBLSPubkey":         "from_bls_pubkey",
+		"BlobKZGCommitments":    "blob_kzg_commitments",
+		"Attestation1":          "attestation_1",
+	} {
+		assert.Equal(t, expected, jsonName(name))
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/config"
+	"oracle/contract"
+	"oracle/fixtures"
+)
+
+// testEth serves a single SentMessage log and the light client head
+type testEth struct {
+	log  types.Log
+	head uint64
+}
+
+func (e *testEth) GetLogs(ctx context.Context, crit map[string]interface{}) ([]types.Log, error) {
+	return []types.Log{e.log}, nil
+}
+
+func (e *testEth) Call(ctx context.Context, args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	return common.BigToHash(new(big.Int).SetUint64(e.head)).Bytes(), nil
+}
+
+func TestExecutorFromFixtures(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	amb, lc := common.Address{1}, common.Address{2}
+	eth := &testEth{
+		log: types.Log{
+			Address:     amb,
+			Topics:      []common.Hash{contract.AMBABI.Events["SentMessage"].ID, {}, common.BigToHash(big.NewInt(7))},
+			Data:        []byte{1, 2, 3},
+			BlockNumber: 100,
+			TxHash:      common.Hash{3},
+			TxIndex:     2,
+			BlockHash:   common.Hash{4},
+			Index:       5,
+		},
+		head: 640,
+	}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", eth))
+	srv := httptest.NewServer(server)
+
+	run := func(cfg config.HTTPClientConfig) (*types.Log, uint64) {
+		raw, err := fixtures.DialRPC(ctx, cfg)
+		require.NoError(t, err)
+		client := ethclient.NewClient(raw)
+		sentLog, err := FindSentMessageLog(ctx, client, amb, 7)
+		require.NoError(t, err)
+		slot, err := GetSyncedSlot(ctx, client, lc)
+		require.NoError(t, err)
+		return sentLog, slot
+	}
+
+	recordedLog, recordedSlot := run(config.HTTPClientConfig{URL: srv.URL, RecordDir: dir})
+	srv.Close()
+	assert.Equal(t, &eth.log, recordedLog)
+	assert.EqualValues(t, 640, recordedSlot)
+
+	replayedLog, replayedSlot := run(config.HTTPClientConfig{ReplayDir: dir})
+	assert.Equal(t, recordedLog, replayedLog)
+	assert.Equal(t, recordedSlot, replayedSlot)
+}
@@ -7,7 +7,13 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
 	"strconv"
+	"strings"
+	"syscall"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -15,16 +21,18 @@ import (
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/ethclient/gethclient"
-	"github.com/ethereum/go-ethereum/rpc"
 
+	"oracle/amb"
 	"oracle/config"
 	"oracle/contract"
+	"oracle/crypto"
+	"oracle/fixtures"
 	"oracle/lightclient"
 	"oracle/sender"
 )
 
 var (
-	sourceBeaconRPC = flag.String("sourceBeaconRPC", "", "")
+	sourceBeaconRPC = flag.String("sourceBeaconRPC", "", "comma separated list of beacon node urls")
 	sourceRPC       = flag.String("sourceRPC", "", "")
 	targetRPC       = flag.String("targetRPC", "", "")
 	sourceAMB       = flag.String("sourceAMB", "", "")
@@ -33,32 +41,49 @@ var (
 	msgNonce        = flag.Int64("msgNonce", 0, "")
 	keystore        = flag.String("keystore", "", "")
 	keystorePass    = flag.String("keystorePass", "", "")
+	cacheDir        = flag.String("cacheDir", "", "directory for caching beacon blocks and states between runs")
+	cacheSizeMB     = flag.Int64("cacheSizeMB", 0, "")
+	recordDir       = flag.String("recordDir", "", "directory for recording all beacon and execution client responses")
+	replayDir       = flag.String("replayDir", "", "directory with the recorded responses to replay instead of calling real nodes")
+	checkOnly       = flag.Bool("check", false, "only verify the message proof against the current contract state, without sending it")
+	hasherName      = flag.String("hasher", "hashtree", "SHA-256 implementation used for merkleization, hashtree (vectorized batches of pairs) or std")
+	hashWorkers     = flag.Int("hashWorkers", runtime.NumCPU(), "number of workers hashing large beacon state lists")
 )
 
 func main() {
 	flag.Parse()
 
-	ctx := context.Background()
+	hasher, err := crypto.ParseHasher(*hasherName, *hashWorkers)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	crypto.SetHasher(hasher)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	lc, err := lightclient.NewLightClient(config.Eth2Config{
-		Client: config.HTTPClientConfig{
-			URL: *sourceBeaconRPC,
+	lc, err := lightclient.NewLightClient(ctx, config.Eth2Config{
+		Client: httpClientConfig("beacon", strings.Split(*sourceBeaconRPC, ",")...),
+		Cache: &config.CacheConfig{
+			Dir:       *cacheDir,
+			MaxSizeMB: *cacheSizeMB,
 		},
 	}, true)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	sourceRawClient, err := rpc.Dial(*sourceRPC)
+	sourceRawClient, err := fixtures.DialRPC(ctx, httpClientConfig("source", *sourceRPC))
 	if err != nil {
 		log.Fatalln(err)
 	}
 	sourceClient := ethclient.NewClient(sourceRawClient)
 	sourceGethClient := gethclient.New(sourceRawClient)
-	targetClient, err := ethclient.Dial(*targetRPC)
+	targetRawClient, err := fixtures.DialRPC(ctx, httpClientConfig("target", *targetRPC))
 	if err != nil {
 		log.Fatalln(err)
 	}
+	targetClient := ethclient.NewClient(targetRawClient)
 
 	sentLog, err := FindSentMessageLog(ctx, sourceClient, common.HexToAddress(*sourceAMB), *msgNonce)
 	if err != nil {
@@ -73,16 +98,16 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	syncedBlock, err := lc.Client.GetBlock(strconv.FormatUint(syncedSlot, 10))
+	syncedBlock, err := lc.Client.GetBlock(ctx, strconv.FormatUint(syncedSlot, 10))
 	if err != nil {
 		log.Fatalln(err)
 	}
-	syncedBlockNumber := syncedBlock.Body.ExecutionPayload.BlockNumber
+	syncedBlockNumber := syncedBlock.ExecutionPayload.BlockNumber
 	if syncedBlockNumber < sentLog.BlockNumber {
 		log.Fatalf("not yet synced to the desired block number, %d < %d \n", syncedBlockNumber, sentLog.BlockNumber)
 	}
 
-	sourceSlot, err := lc.FindBeaconBlockByExecutionBlockNumber(sentLog.BlockNumber)
+	sourceSlot, err := lc.FindBeaconBlockByExecutionBlockNumber(ctx, sentLog.BlockNumber)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -102,11 +127,11 @@ func main() {
 		log.Printf("found already verified storage root log at slot %d\n", verifiedSlot)
 		sourceProofSlot = verifiedSlot
 
-		syncedBlock, err = lc.Client.GetBlock(strconv.FormatUint(uint64(verifiedSlot), 10))
+		syncedBlock, err = lc.Client.GetBlock(ctx, strconv.FormatUint(uint64(verifiedSlot), 10))
 		if err != nil {
 			log.Fatalln(err)
 		}
-		proof, err := sourceGethClient.GetProof(ctx, common.HexToAddress(*sourceAMB), []string{key}, big.NewInt(int64(syncedBlock.Body.ExecutionPayload.BlockNumber)))
+		proof, err := sourceGethClient.GetProof(ctx, common.HexToAddress(*sourceAMB), []string{key}, big.NewInt(int64(syncedBlock.ExecutionPayload.BlockNumber)))
 		if err != nil {
 			log.Fatalln(err)
 		}
@@ -119,7 +144,7 @@ func main() {
 		accountProof = transformProof(proof.AccountProof)
 		storageProof = transformProof(proof.StorageProof[0].Proof)
 
-		stateRootProof, err = lc.MakeExecutionPayloadStateRootProof(syncedSlot)
+		stateRootProof, err = lc.MakeExecutionPayloadStateRootProof(ctx, syncedSlot)
 		if err != nil {
 			log.Fatalln(err)
 		}
@@ -137,6 +162,19 @@ func main() {
 		log.Fatalln(err)
 	}
 
+	state, err := amb.ReadContractState(ctx, targetClient, common.HexToAddress(*targetAMB), uint64(sourceProofSlot), gethcrypto.Keccak256Hash(msg))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	err = amb.VerifyMessage(state, uint64(sourceProofSlot), msg, stateRootProof, accountProof, storageProof)
+	if err != nil {
+		log.Fatalf("Message would be rejected by the contract: %s\n", err)
+	}
+	log.Printf("Message proof is valid, slot %d\n", sourceProofSlot)
+	if *checkOnly {
+		return
+	}
+
 	s, err := sender.NewTxSender(ctx, targetClient, *keystore, *keystorePass)
 	if err != nil {
 		log.Fatalln(err)
@@ -225,3 +263,15 @@ func transformProof(proof []string) [][]byte {
 	}
 	return res
 }
+
+// httpClientConfig makes client config for the given urls, with recording or replaying in the named subdirectory
+func httpClientConfig(name string, urls ...string) config.HTTPClientConfig {
+	cfg := config.HTTPClientConfig{URLs: urls}
+	if *recordDir != "" {
+		cfg.RecordDir = filepath.Join(*recordDir, name)
+	}
+	if *replayDir != "" {
+		cfg.ReplayDir = filepath.Join(*replayDir, name)
+	}
+	return cfg
+}
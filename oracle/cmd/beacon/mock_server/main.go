@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"oracle/beaconclient"
+	"oracle/beaconserver"
+	"oracle/config"
+	"oracle/testchain"
+)
+
+var (
+	addr          = flag.String("addr", "127.0.0.1:5052", "")
+	beaconRPC     = flag.String("beaconRPC", "", "comma separated list of upstream beacon node urls")
+	replayDir     = flag.String("replayDir", "", "directory with the recorded beacon API responses")
+	synthetic     = flag.Uint64("synthetic", 0, "serve synthetic chain, generated up to the given slot")
+	seed          = flag.Int64("seed", 0, "seed of the synthetic chain")
+	participation = flag.Int("participation", 512, "number of sync committee members signing each block of the synthetic chain")
+	cacheDir      = flag.String("cacheDir", "", "directory for caching blocks and states of the upstream or replayed beacon node")
+	cacheSizeMB   = flag.Int64("cacheSizeMB", 0, "")
+)
+
+func main() {
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client, err := makeClient()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	srv := &http.Server{Addr: *addr, Handler: beaconserver.NewServer(client)}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("Serving beacon API on %s\n", *addr)
+	if err = srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalln(err)
+	}
+}
+
+func makeClient() (beaconclient.Eth2Client, error) {
+	var client beaconclient.Eth2Client
+	switch {
+	case *synthetic > 0:
+		log.Printf("Generating synthetic chain up to slot %d\n", *synthetic)
+		chain, err := testchain.NewChain(testchain.Config{
+			Seed:          *seed,
+			Participation: func(slot uint64) int { return *participation },
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err = chain.AdvanceTo(*synthetic); err != nil {
+			return nil, err
+		}
+		return chain, nil
+	case *replayDir != "":
+		client = beaconclient.NewReplayClient(*replayDir)
+	case *beaconRPC != "":
+		urls := strings.Split(*beaconRPC, ",")
+		clients := make([]beaconclient.Eth2Client, len(urls))
+		for i, url := range urls {
+			clients[i] = beaconclient.NewClient(url, config.HTTPClientConfig{})
+		}
+		client = clients[0]
+		if len(clients) > 1 {
+			client = beaconclient.NewMultiClient(1, clients...)
+		}
+	default:
+		return nil, errors.New("one of -synthetic, -replayDir or -beaconRPC is required")
+	}
+	if *cacheDir != "" {
+		return beaconclient.NewCachedClient(client, *cacheDir, *cacheSizeMB<<20)
+	}
+	return client, nil
+}
@@ -8,13 +8,18 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"runtime"
 	"strings"
+	"syscall"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	"oracle/config"
 	"oracle/contract"
+	"oracle/crypto"
+	"oracle/fixtures"
 	"oracle/lightclient"
 )
 
@@ -23,24 +28,39 @@ var (
 	currentSlot = flag.Uint64("currentSlot", 0, "")
 	targetSlot  = flag.Uint64("targetSlot", 0, "")
 	outputFile  = flag.String("output", "./proof_<from>_<to>.json", "")
-	n           = flag.Int("n", 1, "")
+	n           = flag.Int("n", 1, "number of consecutive updates, 0 generates updates up to the latest finalized block")
 	finality    = flag.Bool("finality", true, "")
+	parallel    = flag.Int("parallel", 1, "number of updates generated concurrently")
+	window      = flag.Uint64("window", 0, "number of recent signature blocks compared to choose the best update")
+	hasherName  = flag.String("hasher", "hashtree", "SHA-256 implementation used for merkleization, hashtree (vectorized batches of pairs) or std")
+	hashWorkers = flag.Int("hashWorkers", runtime.NumCPU(), "number of workers hashing large beacon state lists")
 )
 
 func main() {
 	flag.Parse()
 
-	ctx := context.Background()
+	hasher, err := crypto.ParseHasher(*hasherName, *hashWorkers)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	crypto.SetHasher(hasher)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	cfg, err := config.ReadFromFile(*configFile)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	lightClient, err := lightclient.NewLightClient(cfg.Eth2, *finality)
+	lightClient, err := lightclient.NewLightClient(ctx, cfg.Eth2, *finality)
 	if err != nil {
 		log.Fatalln(err)
 	}
+	if *window > 0 && cfg.Eth2.LightClientAPI {
+		log.Fatalln("update window is not supported in light client API mode")
+	}
+	lightClient.UpdateWindow = *window
 
 	slot := *currentSlot
 	if slot == 0 {
@@ -48,10 +68,11 @@ func main() {
 			log.Fatalln("missing eth1 client config")
 		}
 
-		eth1Client, err := ethclient.Dial(cfg.Eth1.Client.URL)
+		rpcClient, err := fixtures.DialRPC(ctx, cfg.Eth1.Client)
 		if err != nil {
 			log.Fatalln(err)
 		}
+		eth1Client := ethclient.NewClient(rpcClient)
 
 		data, err := contract.BeaconLightClientABI.Pack("head")
 		if err != nil {
@@ -69,34 +90,57 @@ func main() {
 	}
 
 	target := *targetSlot
+	if *finality && !cfg.Eth2.LightClientAPI && target == 0 {
+		// finality updates are planned upfront, so that they can be generated concurrently
+		plan, err := lightClient.PlanUpdates(ctx, slot, *n)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		err = lightClient.MakeUpdates(ctx, plan, *parallel, func(step lightclient.UpdateStep, update *lightclient.Update) error {
+			return writeUpdate(step.CurSlot, step.FinalizedSlot, update)
+		})
+		if err != nil {
+			log.Fatalln(err)
+		}
+		return
+	}
+
 	for i := 0; i < *n; i++ {
 		log.Printf("Searching for update from slot %d\n", slot)
-		update, err := lightClient.MakeUpdate(slot, target)
+		update, err := lightClient.MakeUpdate(ctx, slot, target)
 		target = 0
 		if err != nil {
 			log.Fatalln(err)
 		}
 
-		outputFilePath := *outputFile
-		outputFilePath = strings.ReplaceAll(outputFilePath, "<from>", fmt.Sprint(slot))
 		updateTargerSlot := update.AttestedHeader.Slot
 		if update.FinalizedHeader.Slot > 0 {
 			updateTargerSlot = update.FinalizedHeader.Slot
 		}
-		outputFilePath = strings.ReplaceAll(outputFilePath, "<to>", fmt.Sprint(updateTargerSlot))
-		output, err := os.OpenFile(outputFilePath, os.O_CREATE|os.O_RDWR, os.ModePerm)
-		if err != nil {
-			log.Fatalln(fmt.Errorf("can't open file: %w", err))
-		}
-		err = json.NewEncoder(output).Encode(update)
-		if err != nil {
-			log.Fatalln(fmt.Errorf("can't marshal update struct: %w", err))
-		}
-		err = output.Close()
-		if err != nil {
-			log.Fatalln(fmt.Errorf("can't close file: %w", err))
+		if err = writeUpdate(slot, updateTargerSlot, update); err != nil {
+			log.Fatalln(err)
 		}
 
 		slot = updateTargerSlot
 	}
 }
+
+func writeUpdate(from, to uint64, update *lightclient.Update) error {
+	outputFilePath := *outputFile
+	outputFilePath = strings.ReplaceAll(outputFilePath, "<from>", fmt.Sprint(from))
+	outputFilePath = strings.ReplaceAll(outputFilePath, "<to>", fmt.Sprint(to))
+	output, err := os.OpenFile(outputFilePath, os.O_CREATE|os.O_RDWR, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("can't open file: %w", err)
+	}
+	err = json.NewEncoder(output).Encode(update)
+	if err != nil {
+		return fmt.Errorf("can't marshal update struct: %w", err)
+	}
+	err = output.Close()
+	if err != nil {
+		return fmt.Errorf("can't close file: %w", err)
+	}
+	log.Printf("Saved update from slot %d to slot %d into %s\n", from, to, outputFilePath)
+	return nil
+}
@@ -6,12 +6,14 @@ import (
 	"flag"
 	"log"
 	"os"
+	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	"oracle/config"
 	"oracle/contract"
+	"oracle/fixtures"
 	"oracle/lightclient"
 	"oracle/sender"
 )
@@ -20,6 +22,7 @@ var (
 	configFile     = flag.String("config", "./config.yml", "")
 	proofFilePath  = flag.String("proof", "", "")
 	applyCandidate = flag.Bool("apply", false, "")
+	checkOnly      = flag.Bool("check", false, "only verify the proof against the current contract state, without sending it")
 )
 
 func main() {
@@ -32,12 +35,17 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	eth1Client, err := ethclient.Dial(cfg.Eth1.Client.URL)
+	rpcClient, err := fixtures.DialRPC(ctx, cfg.Eth1.Client)
 	if err != nil {
 		log.Fatalln(err)
 	}
+	eth1Client := ethclient.NewClient(rpcClient)
 
-	s, err := sender.NewTxSender(ctx, eth1Client, cfg.Eth1.Keystore, cfg.Eth1.KeystorePassword)
+	lightClient, err := lightclient.NewLightClient(ctx, cfg.Eth2, true)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	state, err := lightclient.ReadContractState(ctx, eth1Client, cfg.Eth1.Contract)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -45,6 +53,10 @@ func main() {
 	var data []byte
 	var proof lightclient.Update
 	if *applyCandidate {
+		best := &state.BestValidUpdate
+		if !lightClient.CanApplyCandidate(best, state.Head, time.Unix(int64(state.Timestamp), 0)) {
+			log.Fatalf("Candidate for slot %d with timeout %d can't be applied yet, head %d, timestamp %d\n", best.Slot, best.Timeout, state.Head, state.Timestamp)
+		}
 		data, err = contract.BeaconLightClientABI.Pack("applyCandidate")
 		if err != nil {
 			log.Fatalln(err)
@@ -59,11 +71,24 @@ func main() {
 		if err != nil {
 			log.Fatalln(err)
 		}
+		res, err := lightClient.VerifyStep(state, &proof)
+		if err != nil {
+			log.Fatalf("Proof would be rejected by the contract with head %d: %s\n", state.Head, err)
+		}
+		log.Printf("Proof is valid, slot %d with %d signatures, final: %t\n", res.ActiveSlot, res.Signatures, res.Final)
 		data, err = contract.BeaconLightClientABI.Pack("step", &proof)
 		if err != nil {
 			log.Fatalln(err)
 		}
 	}
+	if *checkOnly {
+		return
+	}
+
+	s, err := sender.NewTxSender(ctx, eth1Client, cfg.Eth1.Keystore, cfg.Eth1.KeystorePassword)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
 	signedTx, err := s.SendTx(ctx, &types.DynamicFeeTx{
 		To:   &cfg.Eth1.Contract,
@@ -2,105 +2,325 @@ package main
 
 import (
 	"context"
-	"encoding/binary"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
 	"time"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
+	"oracle/beaconclient"
 	"oracle/config"
 	"oracle/contract"
+	"oracle/crypto"
+	"oracle/fixtures"
 	"oracle/lightclient"
 	"oracle/sender"
 )
 
 var (
-	configFile = flag.String("config", "./config.yml", "")
-	interval   = flag.Duration("interval", time.Minute, "")
+	configFile  = flag.String("config", "./config.yml", "")
+	interval    = flag.Duration("interval", time.Minute, "")
+	events      = flag.Bool("events", true, "react to beacon node finalization events, polling every interval only while the event stream is down")
+	parallel    = flag.Int("parallel", 1, "number of updates generated concurrently when catching up over several sync committee periods")
+	optimistic  = flag.Bool("optimistic", false, "submit candidate updates without finality when finality updates are not available, and apply them after UPDATE_TIMEOUT")
+	window      = flag.Uint64("window", 0, "number of recent signature blocks compared to choose the best update, 0 takes the latest block with enough signatures")
+	hasherName  = flag.String("hasher", "hashtree", "SHA-256 implementation used for merkleization, hashtree (vectorized batches of pairs) or std")
+	hashWorkers = flag.Int("hashWorkers", runtime.NumCPU(), "number of workers hashing large beacon state lists")
 )
 
 func main() {
 	flag.Parse()
 
-	ctx := context.Background()
-
-	cfg, err := config.ReadFromFile(*configFile)
+	hasher, err := crypto.ParseHasher(*hasherName, *hashWorkers)
 	if err != nil {
 		log.Fatalln(err)
 	}
+	crypto.SetHasher(hasher)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	lightClient, err := lightclient.NewLightClient(cfg.Eth2, true)
+	cfg, err := config.ReadFromFile(*configFile)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	eth1Client, err := ethclient.Dial(cfg.Eth1.Client.URL)
+	lightClient, err := lightclient.NewLightClient(ctx, cfg.Eth2, true)
 	if err != nil {
 		log.Fatalln(err)
 	}
+	if *window > 0 && cfg.Eth2.LightClientAPI {
+		log.Fatalln("update window is not supported in light client API mode")
+	}
+	lightClient.UpdateWindow = *window
 
-	data, err := contract.BeaconLightClientABI.Pack("head")
+	rpcClient, err := fixtures.DialRPC(ctx, cfg.Eth1.Client)
 	if err != nil {
 		log.Fatalln(err)
 	}
+	eth1Client := ethclient.NewClient(rpcClient)
 
-	res, err := eth1Client.CallContract(ctx, ethereum.CallMsg{
-		To:   &cfg.Eth1.Contract,
-		Data: data,
-	}, nil)
+	state, err := lightclient.ReadContractState(ctx, eth1Client, cfg.Eth1.Contract)
 	if err != nil {
 		log.Fatalln(err)
 	}
-	slot := binary.BigEndian.Uint64(res[24:32])
+	slot := state.Head
 
-	ticker := time.NewTicker(*interval)
+	// candidates are built from the same beacon node, but require neither finality nor 2/3 of signatures
+	optimisticClient := *lightClient
+	optimisticClient.WithFinality = false
+
+	tick := time.NewTicker(*interval).C
+	eventsCh := make(chan *beaconclient.Event)
+	if *events {
+		topics := []string{beaconclient.TopicFinalizedCheckpoint}
+		if cfg.Eth2.LightClientAPI {
+			topics = append(topics, beaconclient.TopicLightClientFinalityUpdate)
+		}
+		stream := beaconclient.NewEventStream(cfg.Eth2.Client.Endpoints(), topics...)
+		go func() {
+			if err := stream.Run(ctx, eventsCh); ctx.Err() == nil {
+				log.Fatalln(err)
+			}
+		}()
+	}
 	s, err := sender.NewTxSender(ctx, eth1Client, cfg.Eth1.Keystore, cfg.Eth1.KeystorePassword)
 	if err != nil {
 		log.Fatalln(err)
 	}
+	streaming := false
 	for {
-		log.Printf("Searching for update from slot %d\n", slot)
-		update, err := lightClient.MakeUpdate(slot, 0)
-		if err != nil {
-			log.Fatalln(err)
-		}
-		if update != nil {
-			updateTargerSlot := update.AttestedHeader.Slot
-			if update.FinalizedHeader.Slot > 0 {
-				updateTargerSlot = update.FinalizedHeader.Slot
-			}
-
-			data, err = contract.BeaconLightClientABI.Pack("step", &update)
-			if err != nil {
+		if !cfg.Eth2.LightClientAPI {
+			behind, err := lightClient.PeriodsBehind(ctx, slot)
+			if err != nil && ctx.Err() == nil && !isRecoverable(err) {
 				log.Fatalln(err)
 			}
-
-			signedTx, err := s.SendTx(ctx, &types.DynamicFeeTx{
-				To:   &cfg.Eth1.Contract,
-				Data: data,
-			})
-			if err != nil {
-				log.Fatalln(err)
-			}
-			log.Printf("Sent tx: %s\n", signedTx.Hash())
-			receipt, err := s.WaitReceipt(ctx, signedTx)
-			if err != nil {
-				log.Fatalln(err)
+			if behind > 1 {
+				log.Printf("Light client head is %d sync committee periods behind, catching up\n", behind)
+				slot = catchUp(ctx, lightClient, s, cfg.Eth1, slot)
 			}
-			log.Println(contract.FormatReceipt(contract.BeaconLightClientABI, receipt))
+		}
 
-			slot = updateTargerSlot
+		log.Printf("Searching for update from slot %d\n", slot)
+		update, err := lightClient.MakeUpdate(ctx, slot, 0)
+		if ctx.Err() != nil {
+			log.Println("Shutting down")
+			return
+		}
+		if isRecoverable(err) {
+			log.Printf("Beacon node can't serve the update yet, will retry later: %s\n", err)
+		} else if err != nil {
+			log.Fatalln(err)
+		} else if update != nil {
+			if slot, err = sendUpdate(ctx, lightClient, s, cfg.Eth1, update); err != nil {
+				log.Printf("Update was not applied, continuing from the contract head %d: %s\n", slot, err)
+			}
+		} else if *optimistic && !cfg.Eth2.LightClientAPI {
+			// no finality update could be made from slot, see manageCandidate
+			slot = manageCandidate(ctx, lightClient, &optimisticClient, s, cfg.Eth1, slot)
 		} else {
 			log.Printf("current slot %d, nothing to update...\n", slot)
 		}
 
+		if !waitForUpdate(ctx, tick, eventsCh, &streaming) {
+			log.Println("Shutting down")
+			return
+		}
+	}
+}
+
+// waitForUpdate blocks until the next update check: a beacon node event arrives, or the interval passes.
+// Ticks are skipped while the event stream is up, except in the optimistic mode, since finalization events
+// are not emitted while the beacon chain is not finalizing. It returns false on shutdown.
+func waitForUpdate(ctx context.Context, tick <-chan time.Time, events <-chan *beaconclient.Event, streaming *bool) bool {
+	for {
 		select {
-		case <-ticker.C:
+		case <-tick:
+			if !*streaming || *optimistic {
+				return true
+			}
+		case event := <-events:
+			if !logEvent(event) {
+				continue
+			}
+			switch event.Topic {
+			case beaconclient.TopicConnected:
+				*streaming = true
+			case beaconclient.TopicDisconnected:
+				*streaming = false
+				continue
+			}
+			return true
 		case <-ctx.Done():
-			break
+			return false
+		}
+	}
+}
+
+// catchUp sends planned updates for all sync committee periods up to the latest finalized block,
+// and returns the new light client head
+func catchUp(ctx context.Context, lightClient *lightclient.LightClient, s *sender.TxSender, cfg *config.Eth1Config, slot uint64) uint64 {
+	plan, err := lightClient.PlanUpdates(ctx, slot, 0)
+	if err == nil {
+		err = lightClient.MakeUpdates(ctx, plan, *parallel, func(step lightclient.UpdateStep, update *lightclient.Update) error {
+			var err2 error
+			slot, err2 = sendUpdate(ctx, lightClient, s, cfg, update)
+			return err2
+		})
+	}
+	if ctx.Err() != nil {
+		return slot
+	}
+	var stepErr *lightclient.StepError
+	if errors.As(err, &stepErr) || errors.Is(err, errReverted) {
+		log.Printf("Catch up was interrupted, continuing from the contract head %d: %s\n", slot, err)
+	} else if isRecoverable(err) {
+		log.Printf("Beacon node can't serve the update yet, will retry later: %s\n", err)
+	} else if err != nil {
+		log.Fatalln(err)
+	}
+	return slot
+}
+
+// manageCandidate applies the stored candidate once the contract allows it, or submits a better candidate,
+// if finality updates can't move the light client head. It returns the new light client head.
+// It must only be called after MakeUpdate returned no finality update from slot, only then a block finalized
+// after slot, as reported by NeedsCandidates, means that no sync aggregate since slot had 2/3 of signatures to prove it.
+func manageCandidate(ctx context.Context, lightClient, optimisticClient *lightclient.LightClient, s *sender.TxSender, cfg *config.Eth1Config, slot uint64) uint64 {
+	needed, err := lightClient.NeedsCandidates(ctx, slot)
+	if err != nil {
+		log.Printf("Can't check finality progress, will retry later: %s\n", err)
+		return slot
+	}
+	if !needed {
+		log.Printf("current slot %d, nothing to update...\n", slot)
+		return slot
+	}
+
+	state, err := lightclient.ReadContractState(ctx, s.Client, cfg.Contract)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	best := &state.BestValidUpdate
+	if lightClient.CanApplyCandidate(best, slot, time.Unix(int64(state.Timestamp), 0)) {
+		log.Printf("Applying candidate update for slot %d with %d signatures\n", best.Slot, best.Signatures)
+		data, err := contract.BeaconLightClientABI.Pack("applyCandidate")
+		if err != nil {
+			log.Fatalln(err)
+		}
+		if err = sendTx(ctx, s, cfg, data); err != nil {
+			log.Printf("Candidate was not applied: %s\n", err)
+			return slot
+		}
+		return best.Slot
+	}
+
+	log.Printf("Searching for candidate update from slot %d\n", slot)
+	update, err := optimisticClient.MakeUpdate(ctx, slot, 0)
+	if ctx.Err() != nil {
+		return slot
+	}
+	if isRecoverable(err) {
+		log.Printf("Beacon node can't serve the update yet, will retry later: %s\n", err)
+	} else if err != nil {
+		log.Fatalln(err)
+	} else if update == nil {
+		log.Printf("current slot %d, no candidate updates...\n", slot)
+	} else if !optimisticClient.IsBetterCandidate(update, best, slot) {
+		log.Printf("Candidate for slot %d with %d signatures is not better than the stored one for slot %d with %d signatures\n",
+			update.ActiveSlot(), optimisticClient.Participants(update), best.Slot, best.Signatures)
+	} else {
+		log.Printf("Submitting candidate for slot %d with %d signatures\n", update.ActiveSlot(), optimisticClient.Participants(update))
+		if _, err = sendUpdate(ctx, lightClient, s, cfg, update); err != nil {
+			log.Printf("Candidate was not sent: %s\n", err)
+		}
+	}
+	return slot
+}
+
+// sendUpdate simulates the step execution against the current contract state before submitting the update,
+// updates that would be reverted are not sent. It returns the light client head after the update.
+func sendUpdate(ctx context.Context, lightClient *lightclient.LightClient, s *sender.TxSender, cfg *config.Eth1Config, update *lightclient.Update) (uint64, error) {
+	state, err := lightclient.ReadContractState(ctx, s.Client, cfg.Contract)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	res, err := lightClient.VerifyStep(state, update)
+	if err != nil {
+		return state.Head, err
+	}
+
+	data, err := contract.BeaconLightClientABI.Pack("step", update)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err = sendTx(ctx, s, cfg, data); err != nil {
+		return state.Head, err
+	}
+	if !res.Final {
+		return state.Head, nil
+	}
+	return res.ActiveSlot, nil
+}
+
+var errReverted = errors.New("transaction was reverted")
+
+// sendTx submits the transaction and waits for its receipt, an error is returned if the transaction is reverted
+func sendTx(ctx context.Context, s *sender.TxSender, cfg *config.Eth1Config, data []byte) error {
+	signedTx, err := s.SendTx(ctx, &types.DynamicFeeTx{
+		To:   &cfg.Contract,
+		Data: data,
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+	log.Printf("Sent tx: %s\n", signedTx.Hash())
+	receipt, err := s.WaitReceipt(ctx, signedTx)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	log.Println(contract.FormatReceipt(contract.BeaconLightClientABI, receipt))
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("tx %s: %w", signedTx.Hash(), errReverted)
+	}
+	return nil
+}
+
+// isRecoverable tells whether the error is caused by the beacon node state or its data,
+// so that the update can succeed later, possibly with another beacon node
+func isRecoverable(err error) bool {
+	return errors.Is(err, beaconclient.SyncingError) || errors.Is(err, beaconclient.ExecutionOptimisticError) ||
+		errors.Is(err, beaconclient.InvalidDataError)
+}
+
+// logEvent returns false for malformed events, which are skipped
+func logEvent(event *beaconclient.Event) bool {
+	switch event.Topic {
+	case beaconclient.TopicConnected:
+		log.Println("Subscribed to beacon node events, checking for missed updates")
+	case beaconclient.TopicDisconnected:
+		log.Printf("Beacon node event stream is down, polling every %s until it is reconnected\n", *interval)
+	case beaconclient.TopicFinalizedCheckpoint:
+		checkpoint, err := event.DecodeFinalizedCheckpoint()
+		if err != nil {
+			log.Printf("Skipping malformed event: %s\n", err)
+			return false
+		}
+		log.Printf("New finalized checkpoint at epoch %d, block %s\n", checkpoint.Epoch, checkpoint.Block)
+	case beaconclient.TopicLightClientFinalityUpdate:
+		update, err := event.DecodeLightClientFinalityUpdate()
+		if err != nil {
+			log.Printf("Skipping malformed event: %s\n", err)
+			return false
 		}
+		log.Printf("New light client finality update, signature slot %d\n", update.SignatureSlot)
 	}
+	return true
 }
@@ -22,13 +22,43 @@ type Eth1Config struct {
 }
 
 type Eth2Config struct {
-	Client  HTTPClientConfig `yaml:"client"`
-	Genesis *GenesisConfig   `yaml:"genesis"`
-	Spec    *SpecConfig      `yaml:"spec"`
+	Client         HTTPClientConfig `yaml:"client"`
+	Genesis        *GenesisConfig   `yaml:"genesis"`
+	Spec           *SpecConfig      `yaml:"spec"`
+	LightClientAPI bool             `yaml:"light_client_api"`
+	// BlockRootQuorum is the number of beacon nodes that should agree on the block root, before the block is used
+	BlockRootQuorum int          `yaml:"block_root_quorum"`
+	Cache           *CacheConfig `yaml:"cache"`
+}
+
+type CacheConfig struct {
+	Dir       string `yaml:"dir"`
+	MaxSizeMB int64  `yaml:"max_size_mb"`
 }
 
 type HTTPClientConfig struct {
 	URL string `yaml:"url"`
+	// URLs are the fallback endpoints, used when the primary URL is unavailable
+	URLs    []string      `yaml:"urls"`
+	Timeout time.Duration `yaml:"timeout"`
+	// Retries is the number of times failed requests are repeated, before giving up.
+	// Each next retry waits twice longer, starting from RetryDelay and up to MaxRetryDelay.
+	Retries       int           `yaml:"retries"`
+	RetryDelay    time.Duration `yaml:"retry_delay"`
+	MaxRetryDelay time.Duration `yaml:"max_retry_delay"`
+	// RecordDir enables recording of all requests and responses into the given directory,
+	// ReplayDir makes the client serve responses from the previously recorded directory instead of the network
+	RecordDir string `yaml:"record_dir"`
+	ReplayDir string `yaml:"replay_dir"`
+}
+
+// Endpoints returns all configured endpoints, starting with the primary one
+func (c HTTPClientConfig) Endpoints() []string {
+	var res []string
+	if c.URL != "" {
+		res = append(res, c.URL)
+	}
+	return append(res, c.URLs...)
 }
 
 type GenesisConfig struct {
@@ -37,18 +67,73 @@ type GenesisConfig struct {
 }
 
 type SpecConfig struct {
-	SecondsPerSlot               uint64 `yaml:"SECONDS_PER_SLOT"`
-	SlotsPerEpoch                uint64 `yaml:"SLOTS_PER_EPOCH"`
-	AltairForkEpoch              uint64 `yaml:"ALTAIR_FORK_EPOCH"`
-	AltairForkVersion            string `yaml:"ALTAIR_FORK_VERSION"`
-	BellatrixForkEpoch           uint64 `yaml:"BELLATRIX_FORK_EPOCH"`
-	BellatrixForkVersion         string `yaml:"BELLATRIX_FORK_VERSION"`
-	EpochsPerSyncCommitteePeriod uint64 `yaml:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
-	SyncCommitteeSize            int    `yaml:"SYNC_COMMITTEE_SIZE"`
-	ValidatorRegistryLimit       int    `yaml:"VALIDATOR_REGISTRY_LIMIT"`
-	HistoricalRootsLimit         int    `yaml:"HISTORICAL_ROOTS_LIMIT"`
-	EpochsPerEth1VotingPeriod    uint64 `yaml:"EPOCHS_PER_ETH1_VOTING_PERIOD"`
-	SlotsPerHistoricalRoot       uint64 `yaml:"SLOTS_PER_HISTORICAL_ROOT"`
+	SecondsPerSlot                 uint64 `yaml:"SECONDS_PER_SLOT"`
+	SlotsPerEpoch                  uint64 `yaml:"SLOTS_PER_EPOCH"`
+	GenesisForkVersion             string `yaml:"GENESIS_FORK_VERSION"`
+	AltairForkEpoch                uint64 `yaml:"ALTAIR_FORK_EPOCH"`
+	AltairForkVersion              string `yaml:"ALTAIR_FORK_VERSION"`
+	BellatrixForkEpoch             uint64 `yaml:"BELLATRIX_FORK_EPOCH"`
+	BellatrixForkVersion           string `yaml:"BELLATRIX_FORK_VERSION"`
+	CapellaForkEpoch               uint64 `yaml:"CAPELLA_FORK_EPOCH"`
+	CapellaForkVersion             string `yaml:"CAPELLA_FORK_VERSION"`
+	DenebForkEpoch                 uint64 `yaml:"DENEB_FORK_EPOCH"`
+	DenebForkVersion               string `yaml:"DENEB_FORK_VERSION"`
+	ElectraForkEpoch               uint64 `yaml:"ELECTRA_FORK_EPOCH"`
+	ElectraForkVersion             string `yaml:"ELECTRA_FORK_VERSION"`
+	FuluForkEpoch                  uint64 `yaml:"FULU_FORK_EPOCH"`
+	FuluForkVersion                string `yaml:"FULU_FORK_VERSION"`
+	EpochsPerSyncCommitteePeriod   uint64 `yaml:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+	SyncCommitteeSize              int    `yaml:"SYNC_COMMITTEE_SIZE"`
+	ValidatorRegistryLimit         int    `yaml:"VALIDATOR_REGISTRY_LIMIT"`
+	HistoricalRootsLimit           int    `yaml:"HISTORICAL_ROOTS_LIMIT"`
+	EpochsPerEth1VotingPeriod      uint64 `yaml:"EPOCHS_PER_ETH1_VOTING_PERIOD"`
+	SlotsPerHistoricalRoot         uint64 `yaml:"SLOTS_PER_HISTORICAL_ROOT"`
+	PendingDepositsLimit           int    `yaml:"PENDING_DEPOSITS_LIMIT"`
+	PendingPartialWithdrawalsLimit int    `yaml:"PENDING_PARTIAL_WITHDRAWALS_LIMIT"`
+	PendingConsolidationsLimit     int    `yaml:"PENDING_CONSOLIDATIONS_LIMIT"`
+}
+
+// Fork is the network upgrade activated at the given epoch
+type Fork struct {
+	Epoch   uint64
+	Version [4]byte
+}
+
+// ForkSchedule returns the forks in the activation order, starting with the genesis fork.
+// Forks with empty version are not scheduled.
+func (s *SpecConfig) ForkSchedule() []Fork {
+	var res []Fork
+	for _, fork := range []struct {
+		epoch   uint64
+		version string
+	}{
+		{0, s.GenesisForkVersion},
+		{s.AltairForkEpoch, s.AltairForkVersion},
+		{s.BellatrixForkEpoch, s.BellatrixForkVersion},
+		{s.CapellaForkEpoch, s.CapellaForkVersion},
+		{s.DenebForkEpoch, s.DenebForkVersion},
+		{s.ElectraForkEpoch, s.ElectraForkVersion},
+		{s.FuluForkEpoch, s.FuluForkVersion},
+	} {
+		if fork.version == "" {
+			continue
+		}
+		var version [4]byte
+		copy(version[:], common.FromHex(fork.version))
+		res = append(res, Fork{Epoch: fork.epoch, Version: version})
+	}
+	return res
+}
+
+// ForkVersionAt returns the version of the latest fork activated at the given epoch
+func (s *SpecConfig) ForkVersionAt(epoch uint64) [4]byte {
+	var res [4]byte
+	for _, fork := range s.ForkSchedule() {
+		if fork.Epoch <= epoch {
+			res = fork.Version
+		}
+	}
+	return res
 }
 
 func ReadFromFile(file string) (*Config, error) {
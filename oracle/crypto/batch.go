@@ -0,0 +1,58 @@
+package crypto
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/crypto/bls/common"
+)
+
+// SignatureSet is a single aggregate signature check, with the same arguments as Verify
+type SignatureSet struct {
+	Message    common.Hash
+	DomainRoot common.Hash
+	PublicKey  G1Point
+	Signature  G2Point
+}
+
+// BatchVerify checks all signature sets with a single multi-pairing. Every set is multiplied by a random scalar,
+// so that invalid signatures can't cancel each other out in the sum. If the batch is rejected, the sets are verified
+// one by one to locate the failures. It returns the sorted indices of invalid sets, nil if all of them are valid.
+func BatchVerify(sets []SignatureSet) []int {
+	var invalid, indices []int
+	var sigs [][]byte
+	var msgs [][32]byte
+	var pks []blscommon.PublicKey
+	for i := range sets {
+		pk, err := sets[i].PublicKey.PublicKey()
+		if err != nil {
+			invalid = append(invalid, i)
+			continue
+		}
+		sig, err := sets[i].Signature.Signature()
+		if err != nil {
+			invalid = append(invalid, i)
+			continue
+		}
+		indices = append(indices, i)
+		sigs = append(sigs, sig.Marshal())
+		msgs = append(msgs, Sha256Hash(sets[i].Message.Bytes(), sets[i].DomainRoot.Bytes()))
+		pks = append(pks, pk)
+	}
+	if len(indices) == 0 {
+		return invalid
+	}
+	if ok, err := blst.VerifyMultipleSignatures(sigs, msgs, pks); err == nil && ok {
+		return invalid
+	}
+
+	for k, i := range indices {
+		sig, err := blst.SignatureFromBytes(sigs[k])
+		if err != nil || !sig.Verify(pks[k], msgs[k][:]) {
+			invalid = append(invalid, i)
+		}
+	}
+	sort.Ints(invalid)
+	return invalid
+}
@@ -0,0 +1,66 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/crypto/bls/blst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeSignatureSets(t testing.TB, n int) []SignatureSet {
+	domainRoot := common.Hash{0xd0}
+	var sets []SignatureSet
+	for i := 0; i < n; i++ {
+		sk, err := blst.RandKey()
+		require.NoError(t, err)
+		// every two sets sign the same message
+		message := common.Hash{byte(i / 2)}
+		root := Sha256Hash(message.Bytes(), domainRoot.Bytes())
+		sets = append(sets, SignatureSet{
+			Message:    message,
+			DomainRoot: domainRoot,
+			PublicKey:  PkToG1(sk.PublicKey()),
+			Signature:  SigToG2(sk.Sign(root[:])),
+		})
+	}
+	return sets
+}
+
+func TestBatchVerify(t *testing.T) {
+	sets := makeSignatureSets(t, 6)
+	assert.Nil(t, BatchVerify(sets))
+	assert.Nil(t, BatchVerify(nil))
+	for _, set := range sets {
+		assert.True(t, Verify(set.Message, set.DomainRoot, set.PublicKey, set.Signature))
+	}
+
+	// swapped signatures of the same message still sum up to a valid aggregate, the random scalars catch them
+	sets[0].Signature, sets[1].Signature = sets[1].Signature, sets[0].Signature
+	sets[4].Message = common.Hash{0xff}
+	assert.Equal(t, []int{0, 1, 4}, BatchVerify(sets))
+
+	// points decoded from json are restored from the coordinates, invalid ones fail without the pairing
+	sets = makeSignatureSets(t, 3)
+	sets[1].PublicKey = G1Point{X: sets[1].PublicKey.X, Y: sets[1].PublicKey.Y}
+	assert.Nil(t, BatchVerify(sets))
+	sets[2].PublicKey = G1Point{X: sets[2].PublicKey.X, Y: sets[1].PublicKey.Y}
+	assert.Equal(t, []int{2}, BatchVerify(sets))
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	sets := makeSignatureSets(b, 16)
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BatchVerify(sets)
+		}
+	})
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, set := range sets {
+				Verify(set.Message, set.DomainRoot, set.PublicKey, set.Signature)
+			}
+		}
+	})
+}
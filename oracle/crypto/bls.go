@@ -1,13 +1,13 @@
 package crypto
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/crypto/bls/blst"
 	blscommon "github.com/prysmaticlabs/prysm/crypto/bls/common"
-	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	blstbind "github.com/supranational/blst/bindings/go"
 )
 
@@ -44,28 +44,95 @@ func (p *G1Point) String() string {
 	return hexutil.Encode(p.raw.Marshal())
 }
 
+// DecodePK decodes the compressed public key, rejecting the point at infinity and points out of the G1 subgroup
+func DecodePK(b []byte) (G1Point, error) {
+	pk, err := decodePublicKey(b)
+	if err != nil {
+		return G1Point{}, err
+	}
+	return PkToG1(pk), nil
+}
+
+// DecodePKCompressed works as DecodePK, returning the point in the compressed form used by the contract
+func DecodePKCompressed(b []byte) (G1PointCompressed, error) {
+	pk, err := decodePublicKey(b)
+	if err != nil {
+		return G1PointCompressed{}, err
+	}
+	return PkToG1Compressed(pk), nil
+}
+
+// DecodeSig decodes the compressed signature, rejecting points out of the G2 subgroup.
+// The point at infinity is rejected as well, since it is only the aggregate of no signatures.
+func DecodeSig(b []byte) (G2Point, error) {
+	if len(b) != 96 {
+		return G2Point{}, fmt.Errorf("signature must be 96 bytes, got %d", len(b))
+	}
+	point := new(blstbind.P2Affine).Uncompress(b)
+	if point == nil {
+		return G2Point{}, fmt.Errorf("signature is not a compressed G2 point")
+	}
+	if isCompressedInfinity(b) {
+		return G2Point{}, fmt.Errorf("signature is the point at infinity")
+	}
+	if !point.InG2() {
+		return G2Point{}, fmt.Errorf("signature is not in the G2 subgroup")
+	}
+	sig, err := blst.SignatureFromBytes(b)
+	if err != nil {
+		return G2Point{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	return SigToG2(sig), nil
+}
+
 func MustDecodePK(b []byte) G1Point {
-	pk, err := blst.PublicKeyFromBytes(b)
+	res, err := DecodePK(b)
 	if err != nil {
 		panic(err)
 	}
-	return PkToG1(pk)
+	return res
 }
 
 func MustDecodePKCompressed(b []byte) G1PointCompressed {
-	pk, err := blst.PublicKeyFromBytes(b)
+	res, err := DecodePKCompressed(b)
 	if err != nil {
 		panic(err)
 	}
-	return PkToG1Compressed(pk)
+	return res
 }
 
 func MustDecodeSig(b []byte) G2Point {
-	sig, err := blst.SignatureFromBytes(b)
+	res, err := DecodeSig(b)
 	if err != nil {
 		panic(err)
 	}
-	return SigToG2(sig)
+	return res
+}
+
+func decodePublicKey(b []byte) (blscommon.PublicKey, error) {
+	if len(b) != 48 {
+		return nil, fmt.Errorf("public key must be 48 bytes, got %d", len(b))
+	}
+	point := new(blstbind.P1Affine).Uncompress(b)
+	if point == nil {
+		return nil, fmt.Errorf("public key is not a compressed G1 point")
+	}
+	if isCompressedInfinity(b) {
+		return nil, fmt.Errorf("public key is the point at infinity")
+	}
+	if !point.InG1() {
+		return nil, fmt.Errorf("public key is not in the G1 subgroup")
+	}
+	pk, err := blst.PublicKeyFromBytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return pk, nil
+}
+
+// isCompressedInfinity checks the infinity flag of the compressed point encoding
+func isCompressedInfinity(b []byte) bool {
+	return b[0]&0x40 != 0
 }
 
 func Verify(hash common.Hash, domainRoot common.Hash, pk G1Point, sig G2Point) bool {
@@ -73,12 +140,13 @@ func Verify(hash common.Hash, domainRoot common.Hash, pk G1Point, sig G2Point) b
 	return sig.raw.Verify(pk.raw, root[:])
 }
 
+// AddG1Points returns the sum of the points, the keys of the arguments are not modified
 func AddG1Points(a *G1Point, b *G1PointCompressed) *G1Point {
 	if a == nil {
-		x := PkToG1(b.raw)
+		x := PkToG1(b.raw.Copy())
 		return &x
 	}
-	x := PkToG1(a.raw.Aggregate(b.raw))
+	x := PkToG1(a.raw.Copy().Aggregate(b.raw))
 	return &x
 }
 
@@ -137,6 +205,78 @@ func SigToG2(sig blscommon.Signature) G2Point {
 	}
 }
 
-func HashG1PointCompressed(point *G1PointCompressed) common.Hash {
-	return Sha256Hash(bytesutil.PadTo(point.raw.Marshal(), 64))
+// PublicKey returns the key of the point, restoring it from the coordinates for points decoded from json
+func (p *G1Point) PublicKey() (blscommon.PublicKey, error) {
+	if p.raw != nil {
+		return p.raw, nil
+	}
+	b := make([]byte, 96)
+	if err := fillFp(b[0:48], p.X); err != nil {
+		return nil, err
+	}
+	if err := fillFp(b[48:96], p.Y); err != nil {
+		return nil, err
+	}
+	return publicKeyFromSerialized(b)
+}
+
+// PublicKey returns the key of the point, restoring it from the coordinates for points decoded from json
+func (p *G1PointCompressed) PublicKey() (blscommon.PublicKey, error) {
+	if p.raw != nil {
+		return p.raw, nil
+	}
+	if p.A == nil || p.XB == nil || p.YB == nil || p.A.BitLen() > 256 || p.XB.BitLen() > 256 || p.YB.BitLen() > 256 {
+		return nil, fmt.Errorf("invalid compressed G1 point coordinates")
+	}
+	a := p.A.FillBytes(make([]byte, 32))
+	b := make([]byte, 96)
+	copy(b[0:16], a[16:32])
+	p.XB.FillBytes(b[16:48])
+	copy(b[48:64], a[0:16])
+	p.YB.FillBytes(b[64:96])
+	return publicKeyFromSerialized(b)
+}
+
+// Signature returns the signature of the point, restoring it from the coordinates for points decoded from json
+func (p *G2Point) Signature() (blscommon.Signature, error) {
+	if p.raw != nil {
+		return p.raw, nil
+	}
+	b := make([]byte, 192)
+	for i, fp := range []Fp{p.X.B, p.X.A, p.Y.B, p.Y.A} {
+		if err := fillFp(b[i*48:i*48+48], fp); err != nil {
+			return nil, err
+		}
+	}
+	point := new(blstbind.P2Affine).Deserialize(b)
+	if point == nil {
+		return nil, fmt.Errorf("G2 point is not on the curve")
+	}
+	sig, err := blst.SignatureFromBytes(point.Compress())
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	return sig, nil
+}
+
+func publicKeyFromSerialized(b []byte) (blscommon.PublicKey, error) {
+	point := new(blstbind.P1Affine).Deserialize(b)
+	if point == nil {
+		return nil, fmt.Errorf("G1 point is not on the curve")
+	}
+	pk, err := blst.PublicKeyFromBytes(point.Compress())
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return pk, nil
+}
+
+// fillFp writes the 48 bytes big endian encoding of the field element, which is split into 16 high and 32 low bytes
+func fillFp(b []byte, fp Fp) error {
+	if fp.A == nil || fp.B == nil || fp.A.BitLen() > 128 || fp.B.BitLen() > 256 {
+		return fmt.Errorf("invalid field element")
+	}
+	fp.A.FillBytes(b[0:16])
+	fp.B.FillBytes(b[16:48])
+	return nil
 }
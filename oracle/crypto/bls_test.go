@@ -0,0 +1,130 @@
+package crypto
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls/blst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	blstbind "github.com/supranational/blst/bindings/go"
+)
+
+func TestRestoreFromCoordinates(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	sig := sk.Sign([]byte("message"))
+
+	var pk G1Point
+	data, err := json.Marshal(PkToG1(sk.PublicKey()))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &pk))
+	restored, err := pk.PublicKey()
+	require.NoError(t, err)
+	assert.True(t, restored.Equals(sk.PublicKey()))
+
+	var pkc G1PointCompressed
+	data, err = json.Marshal(PkToG1Compressed(sk.PublicKey()))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &pkc))
+	restored, err = pkc.PublicKey()
+	require.NoError(t, err)
+	assert.True(t, restored.Equals(sk.PublicKey()))
+
+	var g2 G2Point
+	data, err = json.Marshal(SigToG2(sig))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &g2))
+	restoredSig, err := g2.Signature()
+	require.NoError(t, err)
+	assert.Equal(t, sig.Marshal(), restoredSig.Marshal())
+
+	pk.Y.B.SetInt64(1)
+	_, err = pk.PublicKey()
+	assert.Error(t, err)
+}
+
+func TestDecodePoints(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	sig := sk.Sign([]byte("message"))
+
+	pk, err := DecodePK(sk.PublicKey().Marshal())
+	require.NoError(t, err)
+	assert.Equal(t, PkToG1(sk.PublicKey()), pk)
+	pkc, err := DecodePKCompressed(sk.PublicKey().Marshal())
+	require.NoError(t, err)
+	assert.Equal(t, PkToG1Compressed(sk.PublicKey()), pkc)
+	g2, err := DecodeSig(sig.Marshal())
+	require.NoError(t, err)
+	assert.Equal(t, SigToG2(sig), g2)
+
+	infinityPK := append([]byte{0xc0}, make([]byte, 47)...)
+	infinitySig := append([]byte{0xc0}, make([]byte, 95)...)
+	for name, b := range map[string][]byte{
+		"short":        sk.PublicKey().Marshal()[:47],
+		"uncompressed": make([]byte, 48),
+		"infinity":     infinityPK,
+		"off curve":    append([]byte{0x80}, make([]byte, 47)...),
+		"subgroup":     pointOutOfG1Subgroup(t),
+	} {
+		_, err = DecodePK(b)
+		assert.Error(t, err, name)
+		_, err = DecodePKCompressed(b)
+		assert.Error(t, err, name)
+		assert.Panics(t, func() { MustDecodePK(b) }, name)
+	}
+	for name, b := range map[string][]byte{
+		"short":    sig.Marshal()[:95],
+		"infinity": infinitySig,
+		"garbage":  append([]byte{0x80}, make([]byte, 95)...),
+	} {
+		_, err = DecodeSig(b)
+		assert.Error(t, err, name)
+	}
+}
+
+// pointOutOfG1Subgroup returns the compressed point of the curve y^2 = x^3 + 4 with the smallest x, that is not in G1
+func pointOutOfG1Subgroup(t *testing.T) []byte {
+	p, _ := new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	for x := int64(1); x < 1000; x++ {
+		rhs := new(big.Int).Exp(big.NewInt(x), big.NewInt(3), p)
+		rhs.Add(rhs, big.NewInt(4)).Mod(rhs, p)
+		if new(big.Int).ModSqrt(rhs, p) == nil {
+			continue
+		}
+		res := big.NewInt(x).FillBytes(make([]byte, 48))
+		res[0] |= 0x80
+		if point := new(blstbind.P1Affine).Uncompress(res); point != nil && !point.InG1() {
+			return res
+		}
+	}
+	require.Fail(t, "no point out of G1 subgroup found")
+	return nil
+}
+
+func TestAddG1PointsKeepsArguments(t *testing.T) {
+	keys := make([]G1PointCompressed, 3)
+	for i := range keys {
+		sk, err := blst.RandKey()
+		require.NoError(t, err)
+		keys[i] = PkToG1Compressed(sk.PublicKey())
+	}
+	expected := keys[0].raw.Copy().Aggregate(keys[1].raw).Aggregate(keys[2].raw)
+	first := keys[0].raw.Marshal()
+
+	// the first sum must not share the key with keys[0], otherwise the next additions accumulate into it
+	var sum *G1Point
+	for i := range keys {
+		sum = AddG1Points(sum, &keys[i])
+	}
+	assert.True(t, sum.raw.Equals(expected))
+	assert.Equal(t, first, keys[0].raw.Marshal())
+
+	partial := AddG1Points(nil, &keys[0])
+	partialKey := partial.raw.Marshal()
+	AddG1Points(partial, &keys[1])
+	assert.Equal(t, partialKey, partial.raw.Marshal())
+	assert.Equal(t, first, keys[0].raw.Marshal())
+}
@@ -0,0 +1,342 @@
+package crypto
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// EIP-2537 encodes field elements as 64 bytes big endian numbers, the top 16 bytes are always zero.
+// G1 points are encoded as x || y (128 bytes), G2 points as x.c0 || x.c1 || y.c0 || y.c1 (256 bytes), the zero encoding is the point at infinity.
+// In the ABI structs of BLS12381.sol, Fp.A holds the top 16 bytes of the 48 bytes element and Fp.B the remaining 32 bytes,
+// so that every field element is exactly two 32 bytes precompile words.
+const (
+	eip2537FpSize = 64
+	eip2537G1Size = 2 * eip2537FpSize
+	eip2537G2Size = 4 * eip2537FpSize
+)
+
+// precompiles called by BLS12381.sol, it uses the addresses of the EIP-2537 draft supported by geth
+var (
+	modExpPrecompile     = vm.PrecompiledContractsBerlin[common.BytesToAddress([]byte{0x05})]
+	g1AddPrecompile      = vm.PrecompiledContractsBLS[common.BytesToAddress([]byte{0x0a})]
+	g2AddPrecompile      = vm.PrecompiledContractsBLS[common.BytesToAddress([]byte{0x0d})]
+	pairingPrecompile    = vm.PrecompiledContractsBLS[common.BytesToAddress([]byte{0x10})]
+	mapFp2ToG2Precompile = vm.PrecompiledContractsBLS[common.BytesToAddress([]byte{0x12})]
+)
+
+// blsSigDST is the domain separation tag of BLS12381.sol, the trailing "+" is the length byte of the tag (43)
+const blsSigDST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_+"
+
+var (
+	// fieldModulus is the BLS12-381 base field modulus
+	fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	// halfFieldModulus is (p-1)/2, the largest y of the points, which don't have the sign flag set in the compressed form
+	halfFieldModulus = new(big.Int).Rsh(fieldModulus, 1)
+	// negG1Generator is -P1 as hardcoded in blsPairingCheck
+	negG1Generator = G1Point{
+		X: Fp{A: mustParseBig("31827880280837800241567138048534752271"), B: mustParseBig("88385725958748408079899006800036250932223001591707578097800747617502997169851")},
+		Y: Fp{A: mustParseBig("22997279242622214937712647648895181298"), B: mustParseBig("46816884707101390882112958134453447585552332943769894357249934112654335001290")},
+	}
+)
+
+// EncodeG1 returns the 128 bytes EIP-2537 encoding of the point
+func EncodeG1(p *G1Point) ([]byte, error) {
+	res := make([]byte, eip2537G1Size)
+	for i, fp := range []Fp{p.X, p.Y} {
+		if err := fillEIP2537Fp(res[i*eip2537FpSize:(i+1)*eip2537FpSize], fp); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// EncodeG1Compressed returns the 128 bytes EIP-2537 encoding of the compressed point
+func EncodeG1Compressed(p *G1PointCompressed) ([]byte, error) {
+	point, err := DecompressG1(p)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeG1(&point)
+}
+
+// EncodeG2 returns the 256 bytes EIP-2537 encoding of the point
+func EncodeG2(p *G2Point) ([]byte, error) {
+	res := make([]byte, eip2537G2Size)
+	for i, fp := range []Fp{p.X.A, p.X.B, p.Y.A, p.Y.B} {
+		if err := fillEIP2537Fp(res[i*eip2537FpSize:(i+1)*eip2537FpSize], fp); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// DecodeG1 decodes the 128 bytes EIP-2537 encoding, rejecting the point at infinity and points out of the curve
+func DecodeG1(b []byte) (G1Point, error) {
+	p, err := g1FromEIP2537(b)
+	if err != nil {
+		return G1Point{}, err
+	}
+	pk, err := p.PublicKey()
+	if err != nil {
+		return G1Point{}, err
+	}
+	return PkToG1(pk), nil
+}
+
+// DecodeG2 decodes the 256 bytes EIP-2537 encoding, rejecting the point at infinity and points out of the curve
+func DecodeG2(b []byte) (G2Point, error) {
+	p, err := g2FromEIP2537(b)
+	if err != nil {
+		return G2Point{}, err
+	}
+	sig, err := p.Signature()
+	if err != nil {
+		return G2Point{}, err
+	}
+	return SigToG2(sig), nil
+}
+
+// DecompressG1 restores the point coordinates from the compressed form, as done by BLS12381.addG1.
+// The compressed form only moves the top 16 bytes of y into the unused top half of A, so no curve arithmetic is involved.
+func DecompressG1(p *G1PointCompressed) (G1Point, error) {
+	if p.A == nil || p.XB == nil || p.YB == nil || p.A.BitLen() > 256 {
+		return G1Point{}, fmt.Errorf("invalid compressed G1 point coordinates")
+	}
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	return G1Point{
+		raw: p.raw,
+		X:   Fp{A: new(big.Int).And(p.A, mask), B: new(big.Int).Set(p.XB)},
+		Y:   Fp{A: new(big.Int).Rsh(p.A, 128), B: new(big.Int).Set(p.YB)},
+	}, nil
+}
+
+// HashG1Point mirrors BeaconLightClientCryptoUtils._hashG1, which hashes the compressed public key padded to 64 bytes
+func HashG1Point(point *G1Point) common.Hash {
+	return hashG1(point.X.A, point.X.B, point.Y)
+}
+
+// HashG1PointCompressed mirrors BeaconLightClientCryptoUtils._hashG1Compressed, the result is the same as for HashG1Point
+func HashG1PointCompressed(point *G1PointCompressed) common.Hash {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	yA := new(big.Int).Rsh(point.A, 128)
+	return hashG1(new(big.Int).And(point.A, mask), point.XB, Fp{A: yA, B: point.YB})
+}
+
+// hashG1 builds the 48 bytes compressed encoding of the point from its x limbs and the sign of y, and hashes it padded with zeros
+func hashG1(xA *big.Int, xB *big.Int, y Fp) common.Hash {
+	b := make([]byte, 64)
+	xA.FillBytes(b[0:16])
+	xB.FillBytes(b[16:48])
+	// compression flag, and the sign flag for y > (p-1)/2
+	b[0] |= 0x80
+	if new(big.Int).Or(new(big.Int).Lsh(y.A, 256), y.B).Cmp(halfFieldModulus) > 0 {
+		b[0] |= 0x20
+	}
+	return Sha256Hash(b)
+}
+
+// VerifyBLSSignature mirrors BLS12381.verifyBLSSignature: the message is hashed to G2 as in the contract
+// and the pairing is checked by the same precompiles, which are run by geth
+func VerifyBLSSignature(message common.Hash, pk *G1Point, sig *G2Point) (bool, error) {
+	messageOnCurve, err := hashToCurve(message)
+	if err != nil {
+		return false, fmt.Errorf("can't hash message to curve: %w", err)
+	}
+	return blsPairingCheck(pk, &messageOnCurve, sig)
+}
+
+// expandMessage implements expand_message_xmd with SHA-256 for 256 bytes of output
+func expandMessage(message common.Hash) []byte {
+	b0 := Sha256Hash(make([]byte, 64), message[:], []byte{0x01, 0x00, 0x00}, []byte(blsSigDST))
+	res := make([]byte, 0, 256)
+	chunk := Sha256Hash(b0[:], []byte{0x01}, []byte(blsSigDST))
+	res = append(res, chunk[:]...)
+	for i := 2; i < 9; i++ {
+		var input common.Hash
+		for j := range input {
+			input[j] = b0[j] ^ chunk[j]
+		}
+		chunk = Sha256Hash(input[:], []byte{byte(i)}, []byte(blsSigDST))
+		res = append(res, chunk[:]...)
+	}
+	return res
+}
+
+// reduceModulo reduces the big endian number modulo the field modulus with the modexp precompile, raising it to the power of 1
+func reduceModulo(b []byte) (Fp, error) {
+	input := make([]byte, 96, 96+len(b)+32+48)
+	new(big.Int).SetInt64(int64(len(b))).FillBytes(input[0:32])
+	big.NewInt(32).FillBytes(input[32:64])
+	big.NewInt(48).FillBytes(input[64:96])
+	input = append(input, b...)
+	input = append(input, big.NewInt(1).FillBytes(make([]byte, 32))...)
+	input = append(input, fieldModulus.FillBytes(make([]byte, 48))...)
+	out, err := modExpPrecompile.Run(input)
+	if err != nil {
+		return Fp{}, fmt.Errorf("call to modular exponentiation precompile failed: %w", err)
+	}
+	return Fp{A: new(big.Int).SetBytes(out[0:16]), B: new(big.Int).SetBytes(out[16:48])}, nil
+}
+
+func hashToField(message common.Hash) ([2]Fp2, error) {
+	var res [2]Fp2
+	data := expandMessage(message)
+	for i := range res {
+		for j, fp := range []*Fp{&res[i].A, &res[i].B} {
+			start := (2*i + j) * 64
+			var err error
+			if *fp, err = reduceModulo(data[start : start+64]); err != nil {
+				return res, err
+			}
+		}
+	}
+	return res, nil
+}
+
+func mapToCurve(fe Fp2) (G2Point, error) {
+	input := make([]byte, 2*eip2537FpSize)
+	for i, fp := range []Fp{fe.A, fe.B} {
+		if err := fillEIP2537Fp(input[i*eip2537FpSize:(i+1)*eip2537FpSize], fp); err != nil {
+			return G2Point{}, err
+		}
+	}
+	out, err := mapFp2ToG2Precompile.Run(input)
+	if err != nil {
+		return G2Point{}, fmt.Errorf("call to map to curve precompile failed: %w", err)
+	}
+	return g2FromEIP2537(out)
+}
+
+func addG2(a *G2Point, b *G2Point) (G2Point, error) {
+	input, err := concatEncodings(EncodeG2, a, b)
+	if err != nil {
+		return G2Point{}, err
+	}
+	out, err := g2AddPrecompile.Run(input)
+	if err != nil {
+		return G2Point{}, fmt.Errorf("call to addition in G2 precompile failed: %w", err)
+	}
+	return g2FromEIP2537(out)
+}
+
+// addG1 mirrors BLS12381.addG1, which adds the compressed point to the aggregated public key
+func addG1(a *G1Point, b *G1PointCompressed) (G1Point, error) {
+	decompressed, err := DecompressG1(b)
+	if err != nil {
+		return G1Point{}, err
+	}
+	input, err := concatEncodings(EncodeG1, a, &decompressed)
+	if err != nil {
+		return G1Point{}, err
+	}
+	out, err := g1AddPrecompile.Run(input)
+	if err != nil {
+		return G1Point{}, fmt.Errorf("call to addition in G1 precompile failed: %w", err)
+	}
+	return g1FromEIP2537(out)
+}
+
+func hashToCurve(message common.Hash) (G2Point, error) {
+	fes, err := hashToField(message)
+	if err != nil {
+		return G2Point{}, err
+	}
+	first, err := mapToCurve(fes[0])
+	if err != nil {
+		return G2Point{}, err
+	}
+	second, err := mapToCurve(fes[1])
+	if err != nil {
+		return G2Point{}, err
+	}
+	return addG2(&first, &second)
+}
+
+// blsPairingCheck checks e(pk, H(m)) * e(-P1, sig) == 1
+func blsPairingCheck(pk *G1Point, messageOnCurve *G2Point, sig *G2Point) (bool, error) {
+	var input []byte
+	for _, pair := range []struct {
+		g1 *G1Point
+		g2 *G2Point
+	}{{pk, messageOnCurve}, {&negG1Generator, sig}} {
+		g1, err := EncodeG1(pair.g1)
+		if err != nil {
+			return false, err
+		}
+		g2, err := EncodeG2(pair.g2)
+		if err != nil {
+			return false, err
+		}
+		input = append(append(input, g1...), g2...)
+	}
+	out, err := pairingPrecompile.Run(input)
+	if err != nil {
+		return false, fmt.Errorf("call to pairing precompile failed: %w", err)
+	}
+	return new(big.Int).SetBytes(out).Cmp(big.NewInt(1)) == 0, nil
+}
+
+func concatEncodings[T any](encode func(*T) ([]byte, error), points ...*T) ([]byte, error) {
+	var res []byte
+	for _, p := range points {
+		b, err := encode(p)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, b...)
+	}
+	return res, nil
+}
+
+// g1FromEIP2537 splits the encoding into the contract limbs without any curve checks
+func g1FromEIP2537(b []byte) (G1Point, error) {
+	if len(b) != eip2537G1Size {
+		return G1Point{}, fmt.Errorf("G1 point must be %d bytes, got %d", eip2537G1Size, len(b))
+	}
+	var fps [2]Fp
+	for i := range fps {
+		var err error
+		if fps[i], err = parseEIP2537Fp(b[i*eip2537FpSize : (i+1)*eip2537FpSize]); err != nil {
+			return G1Point{}, err
+		}
+	}
+	return G1Point{X: fps[0], Y: fps[1]}, nil
+}
+
+// g2FromEIP2537 splits the encoding into the contract limbs without any curve checks
+func g2FromEIP2537(b []byte) (G2Point, error) {
+	if len(b) != eip2537G2Size {
+		return G2Point{}, fmt.Errorf("G2 point must be %d bytes, got %d", eip2537G2Size, len(b))
+	}
+	var fps [4]Fp
+	for i := range fps {
+		var err error
+		if fps[i], err = parseEIP2537Fp(b[i*eip2537FpSize : (i+1)*eip2537FpSize]); err != nil {
+			return G2Point{}, err
+		}
+	}
+	return G2Point{X: Fp2{A: fps[0], B: fps[1]}, Y: Fp2{A: fps[2], B: fps[3]}}, nil
+}
+
+func fillEIP2537Fp(b []byte, fp Fp) error {
+	return fillFp(b[eip2537FpSize-48:], fp)
+}
+
+func parseEIP2537Fp(b []byte) (Fp, error) {
+	if !bytes.Equal(b[:eip2537FpSize-48], make([]byte, eip2537FpSize-48)) {
+		return Fp{}, fmt.Errorf("field element has non-zero padding")
+	}
+	return Fp{A: new(big.Int).SetBytes(b[16:32]), B: new(big.Int).SetBytes(b[32:64])}, nil
+}
+
+func mustParseBig(s string) *big.Int {
+	res, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic(fmt.Sprintf("invalid number %s", s))
+	}
+	return res
+}
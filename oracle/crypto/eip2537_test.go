@@ -0,0 +1,110 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	blstbind "github.com/supranational/blst/bindings/go"
+)
+
+func TestEIP2537Encoding(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	pk := PkToG1(sk.PublicKey())
+	pkc := PkToG1Compressed(sk.PublicKey())
+	sig := SigToG2(sk.Sign([]byte("message")))
+
+	// EIP-2537 encoding is the uncompressed serialization with every coordinate padded to 64 bytes,
+	// G2 coordinates are serialized as c1 || c0 by blst
+	g1 := new(blstbind.P1Affine).Uncompress(sk.PublicKey().Marshal()).Serialize()
+	g2 := new(blstbind.P2Affine).Uncompress(sig.raw.Marshal()).Serialize()
+	var expectedG1, expectedG2 []byte
+	for _, fp := range [][]byte{g1[0:48], g1[48:96]} {
+		expectedG1 = append(expectedG1, bytesutil.PadTo(nil, 16)...)
+		expectedG1 = append(expectedG1, fp...)
+	}
+	for _, fp := range [][]byte{g2[48:96], g2[0:48], g2[144:192], g2[96:144]} {
+		expectedG2 = append(expectedG2, bytesutil.PadTo(nil, 16)...)
+		expectedG2 = append(expectedG2, fp...)
+	}
+
+	b, err := EncodeG1(&pk)
+	require.NoError(t, err)
+	assert.Equal(t, expectedG1, b)
+	b, err = EncodeG1Compressed(&pkc)
+	require.NoError(t, err)
+	assert.Equal(t, expectedG1, b)
+	decoded, err := DecodeG1(b)
+	require.NoError(t, err)
+	assert.Equal(t, pk, decoded)
+
+	b, err = EncodeG2(&sig)
+	require.NoError(t, err)
+	assert.Equal(t, expectedG2, b)
+	decodedSig, err := DecodeG2(b)
+	require.NoError(t, err)
+	assert.Equal(t, sig, decodedSig)
+
+	_, err = DecodeG1(expectedG1[:127])
+	assert.Error(t, err)
+	_, err = DecodeG1(make([]byte, 128))
+	assert.Error(t, err, "point at infinity")
+	padded := append([]byte{}, expectedG1...)
+	padded[0] = 1
+	_, err = DecodeG1(padded)
+	assert.Error(t, err, "non-zero padding")
+	notOnCurve := append([]byte{}, expectedG2...)
+	notOnCurve[255] ^= 1
+	_, err = DecodeG2(notOnCurve)
+	assert.Error(t, err)
+}
+
+func TestContractMirror(t *testing.T) {
+	message := common.HexToHash("0x5c1b6b7b2c4e1a2f1d2c3b4a5968778695a4b3c2d1e0f1e2d3c4b5a697887960")
+
+	var aggregate *G1Point
+	var sigs []blscommon.Signature
+	var compressed []G1PointCompressed
+	for i := 0; i < 4; i++ {
+		sk, err := blst.RandKey()
+		require.NoError(t, err)
+		pk := PkToG1(sk.PublicKey())
+		pkc := PkToG1Compressed(sk.PublicKey())
+		compressed = append(compressed, pkc)
+		sigs = append(sigs, sk.Sign(message[:]))
+
+		hash := Sha256Hash(bytesutil.PadTo(sk.PublicKey().Marshal(), 64))
+		assert.Equal(t, hash, HashG1Point(&pk))
+		assert.Equal(t, hash, HashG1PointCompressed(&pkc))
+
+		// addG1 works on the contract limbs only
+		if aggregate != nil {
+			sum, err := addG1(aggregate, &pkc)
+			require.NoError(t, err)
+			expected := AddG1Points(aggregate, &pkc)
+			assert.Equal(t, expected.X, sum.X)
+			assert.Equal(t, expected.Y, sum.Y)
+		}
+		aggregate = AddG1Points(aggregate, &pkc)
+	}
+
+	sig := SigToG2(blst.AggregateSignatures(sigs))
+	ok, err := VerifyBLSSignature(message, aggregate, &sig)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, sig.raw.FastAggregateVerify([]blscommon.PublicKey{compressed[0].raw, compressed[1].raw, compressed[2].raw, compressed[3].raw}, message))
+
+	ok, err = VerifyBLSSignature(common.Hash{1}, aggregate, &sig)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	single := PkToG1(compressed[0].raw)
+	ok, err = VerifyBLSSignature(message, &single, &sig)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -2,17 +2,149 @@ package crypto
 
 import (
 	"crypto/sha256"
+	"fmt"
+	"sync"
+	"unsafe"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/gohashtree"
 )
 
+// Hasher computes SHA-256 hashes for merkleization
+type Hasher interface {
+	// Hash returns the hash of the concatenated data
+	Hash(data ...[]byte) common.Hash
+	// HashPairs writes the hash of each pair of consecutive chunks to dst, len(chunks) must be 2*len(dst)
+	HashPairs(dst []common.Hash, chunks []common.Hash)
+}
+
+var (
+	// StdHasher uses crypto/sha256 from the standard library
+	StdHasher Hasher = stdHasher{}
+	// HashtreeHasher hashes batches of pairs with gohashtree, which processes several pairs at once using
+	// AVX2, AVX512, SHA extensions or NEON instructions, and falls back to a generic implementation on other CPUs
+	HashtreeHasher Hasher = hashtreeHasher{}
+)
+
+// hasher is used by Sha256Hash and merkle trees
+var hasher = StdHasher
+
+// SetHasher selects the hasher used by Sha256Hash, merkle trees and SSZ schemas.
+// It is not synchronized with hashing and is expected to be called once on startup.
+func SetHasher(h Hasher) {
+	hasher = h
+}
+
+// ParseHasher returns the hasher by its name, std or hashtree, it is wrapped into the parallel hasher if more than one worker is requested
+func ParseHasher(name string, workers int) (Hasher, error) {
+	var res Hasher
+	switch name {
+	case "std":
+		res = StdHasher
+	case "hashtree":
+		res = HashtreeHasher
+	default:
+		return nil, fmt.Errorf("unknown hasher %q, std or hashtree expected", name)
+	}
+	if workers > 1 {
+		res = NewParallelHasher(res, workers)
+	}
+	return res, nil
+}
+
 func Sha256Hash(bs ...[]byte) common.Hash {
+	return hasher.Hash(bs...)
+}
+
+type stdHasher struct{}
+
+func (stdHasher) Hash(data ...[]byte) common.Hash {
 	h := sha256.New()
-	h.Reset()
-	for _, b := range bs {
+	for _, b := range data {
 		h.Write(b)
 	}
 	res := common.Hash{}
 	h.Sum(res[:0:32])
 	return res
 }
+
+func (stdHasher) HashPairs(dst []common.Hash, chunks []common.Hash) {
+	var buf [64]byte
+	for i := range dst {
+		copy(buf[:32], chunks[2*i][:])
+		copy(buf[32:], chunks[2*i+1][:])
+		dst[i] = sha256.Sum256(buf[:])
+	}
+}
+
+// hashtreeHasher hashes single messages with crypto/sha256, gohashtree only handles 64 byte inputs
+type hashtreeHasher struct {
+	stdHasher
+}
+
+func (hashtreeHasher) HashPairs(dst []common.Hash, chunks []common.Hash) {
+	if len(dst) == 0 {
+		return
+	}
+	gohashtree.HashChunks(hashArrays(dst), hashArrays(chunks[:2*len(dst)]))
+}
+
+// hashArrays reinterprets the hashes as the arrays expected by gohashtree without copying
+func hashArrays(hashes []common.Hash) [][32]byte {
+	return unsafe.Slice((*[32]byte)(&hashes[0]), len(hashes))
+}
+
+// minParallelPairs is the smallest batch split between workers, smaller ones are not worth the goroutines
+const minParallelPairs = 1024
+
+// ParallelHasher splits large batches of pairs between workers, it is also used by SSZ schemas to merkleize large lists in parallel
+type ParallelHasher struct {
+	Hasher
+	workers int
+}
+
+func NewParallelHasher(h Hasher, workers int) *ParallelHasher {
+	if workers < 1 {
+		workers = 1
+	}
+	return &ParallelHasher{Hasher: h, workers: workers}
+}
+
+func (h *ParallelHasher) Workers() int {
+	return h.workers
+}
+
+func (h *ParallelHasher) HashPairs(dst []common.Hash, chunks []common.Hash) {
+	if len(dst) < minParallelPairs || h.workers == 1 {
+		h.Hasher.HashPairs(dst, chunks)
+		return
+	}
+	parallelFor(len(dst), h.workers, func(from, to int) {
+		h.Hasher.HashPairs(dst[from:to], chunks[2*from:2*to])
+	})
+}
+
+// hashWorkers returns the number of workers of the current hasher
+func hashWorkers() int {
+	if h, ok := hasher.(*ParallelHasher); ok {
+		return h.workers
+	}
+	return 1
+}
+
+// parallelFor splits [0, n) into a range per worker and waits for all of them
+func parallelFor(n int, workers int, f func(from, to int)) {
+	if workers > n {
+		workers = n
+	}
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		from, to := n*w/workers, n*(w+1)/workers
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f(from, to)
+		}()
+	}
+	wg.Wait()
+}
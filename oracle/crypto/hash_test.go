@@ -0,0 +1,94 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashers(t *testing.T) {
+	chunks := make([]common.Hash, 2*3000)
+	for i := range chunks {
+		chunks[i] = UintToHash(uint64(i))
+	}
+	expected := make([]common.Hash, len(chunks)/2)
+	for i := range expected {
+		expected[i] = StdHasher.Hash(chunks[2*i].Bytes(), chunks[2*i+1].Bytes())
+	}
+	for name, h := range map[string]Hasher{
+		"std":      StdHasher,
+		"hashtree": HashtreeHasher,
+		"parallel": NewParallelHasher(HashtreeHasher, 4),
+	} {
+		assert.Equal(t, StdHasher.Hash([]byte("abc"), []byte("def")), h.Hash([]byte("abcdef")), name)
+		res := make([]common.Hash, len(expected))
+		h.HashPairs(res, chunks)
+		assert.Equal(t, expected, res, name)
+	}
+
+	// vectorized implementations hash several pairs at once, the remaining pairs are hashed separately
+	for n := 0; n <= 17; n++ {
+		res := make([]common.Hash, n)
+		HashtreeHasher.HashPairs(res, chunks[:2*n])
+		assert.Equal(t, expected[:n], res, "%d pairs", n)
+	}
+
+	_, err := ParseHasher("simd", 1)
+	assert.Error(t, err)
+	h, err := ParseHasher("hashtree", 8)
+	require.NoError(t, err)
+	assert.Equal(t, 8, h.(*ParallelHasher).Workers())
+	assert.Equal(t, HashtreeHasher, h.(*ParallelHasher).Hasher)
+}
+
+func TestParallelMerkleization(t *testing.T) {
+	defer SetHasher(hasher)
+
+	schema := NewSSZList(NewSSZContainer(
+		NewSSZField("Pubkey", NewSSZByteVector(48)),
+		NewSSZField("Balance", SSZUint64),
+	), 1<<20)
+	type validator struct {
+		Pubkey  []byte
+		Balance uint64
+	}
+	validators := make([]*validator, 5000)
+	for i := range validators {
+		validators[i] = &validator{Pubkey: make([]byte, 48), Balance: uint64(i)}
+	}
+	expected, err := schema.HashTreeRoot(validators)
+	require.NoError(t, err)
+
+	SetHasher(NewParallelHasher(HashtreeHasher, 4))
+	res, err := schema.HashTreeRoot(validators)
+	require.NoError(t, err)
+	assert.Equal(t, expected, res)
+
+	validators[4000].Pubkey = make([]byte, 47)
+	_, err = schema.HashTreeRoot(validators)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[4000]")
+}
+
+func BenchmarkHashers(b *testing.B) {
+	defer SetHasher(hasher)
+
+	leaves := make([]common.Hash, 1<<20)
+	for i := range leaves {
+		leaves[i] = UintToHash(uint64(i))
+	}
+	for name, h := range map[string]Hasher{
+		"std":      StdHasher,
+		"hashtree": HashtreeHasher,
+		"parallel": NewParallelHasher(HashtreeHasher, 8),
+	} {
+		b.Run(name, func(b *testing.B) {
+			SetHasher(h)
+			for i := 0; i < b.N; i++ {
+				NewListMerkleTree(leaves, 1<<40).Hash()
+			}
+		})
+	}
+}
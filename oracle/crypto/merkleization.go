@@ -2,15 +2,24 @@ package crypto
 
 import (
 	"fmt"
+	"math/bits"
+	"sort"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// MerkleTree is a binary merkle tree over the given leaves, padded with zero chunks up to the limit.
+// Layers are computed once on the first use and keep only the nodes above the actual leaves,
+// the rest of the tree consists of zero subtrees, that are taken from precomputed zero hashes.
 type MerkleTree struct {
 	isList bool
 	length int
 	limit  int
 	leaves []common.Hash
+
+	once   sync.Once
+	layers [][]common.Hash
 }
 
 type MerkleProof struct {
@@ -24,6 +33,13 @@ type MerkleMultiProof struct {
 	Decommitments []common.Hash
 }
 
+func NewMerkleProof(genIndex int, path []common.Hash) *MerkleProof {
+	return &MerkleProof{
+		genIndex: genIndex,
+		Path:     path,
+	}
+}
+
 func NewVectorMerkleTree(leaves ...common.Hash) *MerkleTree {
 	return &MerkleTree{
 		isList: false,
@@ -67,43 +83,26 @@ func NewPackedListMerkleTree(data []byte, length, limit int) *MerkleTree {
 }
 
 func (t *MerkleTree) Hash() common.Hash {
-	x := merkle(t.leaves, t.limit)
+	x := t.node(t.depth(), 0)
 	if t.isList {
 		return Sha256Hash(x.Bytes(), UintToHash(uint64(t.length)).Bytes())
 	}
 	return x
 }
 
+// Leaves returns the leaves of the tree, without the zero padding
+func (t *MerkleTree) Leaves() []common.Hash {
+	return t.leaves
+}
+
 func (t *MerkleTree) MakeProof(idx int) *MerkleProof {
 	if idx < 0 || idx >= len(t.leaves) {
 		panic("index out of bounds")
 	}
-	genIdx := idx + t.limit
-	path := make([]common.Hash, 0, 10)
-
-	l := idx
-	r := l + 1
-	for k := 1; genIdx > 1; k *= 2 {
-		if genIdx%2 == 1 {
-			l -= k
-			e := r - k
-			if e >= len(t.leaves) {
-				e = len(t.leaves)
-			}
-			path = append(path, merkle(t.leaves[l:e], k))
-		} else {
-			r += k
-			e := r
-			if e >= len(t.leaves) {
-				e = len(t.leaves)
-			}
-			if l+k <= e {
-				path = append(path, merkle(t.leaves[l+k:e], k))
-			} else {
-				path = append(path, merkle(t.leaves[e:e], k))
-			}
-		}
-		genIdx /= 2
+	depth := t.depth()
+	path := make([]common.Hash, 0, depth+1)
+	for level := 0; level < depth; level++ {
+		path = append(path, t.node(level, (idx>>level)^1))
 	}
 	if t.isList {
 		return &MerkleProof{
@@ -117,6 +116,8 @@ func (t *MerkleTree) MakeProof(idx int) *MerkleProof {
 	}
 }
 
+// MakeMultiProof makes a multiproof for the given leaves, decommitments are ordered as HelperIndices.
+// For lists, the proof is rooted in the data tree, without the length mix-in.
 func (t *MerkleTree) MakeMultiProof(indices []int) *MerkleMultiProof {
 	if len(indices) == 0 {
 		return &MerkleMultiProof{
@@ -126,76 +127,122 @@ func (t *MerkleTree) MakeMultiProof(indices []int) *MerkleMultiProof {
 
 	genIndices := make([]int, 0, len(indices))
 	leavesHashes := make([]common.Hash, 0, len(indices))
-	decommitments := make([]common.Hash, 0, len(indices))
-	known := make(map[int]bool, len(indices))
-	hashes := make(map[int]common.Hash, len(indices))
-	for i := 0; i < t.limit; i++ {
-		if i < len(t.leaves) {
-			hashes[i+t.limit] = t.leaves[i]
-		} else {
-			hashes[i+t.limit] = ZeroHash(0)
-		}
-	}
 	for i := len(indices) - 1; i >= 0; i-- {
 		if indices[i] < 0 || indices[i] >= len(t.leaves) {
 			panic("index out of bounds")
 		}
 		genIndices = append(genIndices, indices[i]+t.limit)
 		leavesHashes = append(leavesHashes, t.leaves[indices[i]])
-		known[indices[i]+t.limit] = true
+	}
+	helpers := HelperIndices(genIndices)
+	decommitments := make([]common.Hash, 0, len(helpers))
+	depth := t.depth()
+	for _, index := range helpers {
+		level := depth - (bits.Len(uint(index)) - 1)
+		decommitments = append(decommitments, t.node(level, index-1<<(depth-level)))
 	}
 
-	for i := t.limit*2 - 1; i > 1; i -= 2 {
-		left := known[i-1]
-		right := known[i]
-		if left && !right {
-			decommitments = append(decommitments, hashes[i])
-		}
-		if !left && right {
-			decommitments = append(decommitments, hashes[i-1])
+	return &MerkleMultiProof{
+		genIndices:    genIndices,
+		leavesHashes:  leavesHashes,
+		Decommitments: decommitments,
+	}
+}
+
+func (t *MerkleTree) depth() int {
+	return bits.Len(uint(t.limit)) - 1
+}
+
+// node returns the i-th node of the given level, counting from the leaves
+func (t *MerkleTree) node(level int, i int) common.Hash {
+	t.once.Do(t.build)
+	return layerNode(t.layers, level, i)
+}
+
+func layerNode(layers [][]common.Hash, level int, i int) common.Hash {
+	if i < len(layers[level]) {
+		return layers[level][i]
+	}
+	return zeroHashes[level]
+}
+
+func (t *MerkleTree) build() {
+	depth := t.depth()
+	t.layers = make([][]common.Hash, depth+1)
+	t.layers[0] = t.leaves
+	for level := 1; level <= depth; level++ {
+		prev := t.layers[level-1]
+		layer := make([]common.Hash, (len(prev)+1)/2)
+		hasher.HashPairs(layer[:len(prev)/2], prev[:len(prev)/2*2])
+		if len(prev)%2 == 1 {
+			layer[len(layer)-1] = Sha256Hash(prev[len(prev)-1].Bytes(), zeroHashes[level-1].Bytes())
 		}
-		known[i/2] = left || right
-		hashes[i/2] = Sha256Hash(hashes[i-1].Bytes(), hashes[i].Bytes())
+		t.layers[level] = layer
 	}
+}
 
+func NewMerkleMultiProof(genIndices []int, leaves []common.Hash, decommitments []common.Hash) *MerkleMultiProof {
 	return &MerkleMultiProof{
 		genIndices:    genIndices,
-		leavesHashes:  leavesHashes,
+		leavesHashes:  leaves,
 		Decommitments: decommitments,
 	}
 }
 
+func (p *MerkleMultiProof) GenIndices() []int {
+	return p.genIndices
+}
+
+func (p *MerkleMultiProof) Leaves() []common.Hash {
+	return p.leavesHashes
+}
+
+// ReconstructRoot calculates the root as calculate_multi_merkle_root from the consensus specs,
+// so that the proven leaves may have different depths. Decommitments are ordered as HelperIndices.
 func (p *MerkleMultiProof) ReconstructRoot() common.Hash {
 	if len(p.genIndices) == 0 {
 		return p.Decommitments[0]
 	}
+	helpers := HelperIndices(p.genIndices)
+	if len(helpers) != len(p.Decommitments) || len(p.genIndices) != len(p.leavesHashes) {
+		panic("invalid proof length")
+	}
+	nodes := make(map[int]common.Hash, len(p.genIndices)+len(helpers))
+	keys := make([]int, 0, 2*len(nodes))
+	for i, index := range p.genIndices {
+		nodes[index] = p.leavesHashes[i]
+		keys = append(keys, index)
+	}
+	for i, index := range helpers {
+		nodes[index] = p.Decommitments[i]
+		keys = append(keys, index)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(keys)))
+	for pos := 0; pos < len(keys); pos++ {
+		index := keys[pos]
+		_, hasSibling := nodes[index^1]
+		_, hasParent := nodes[index/2]
+		if index > 1 && hasSibling && !hasParent {
+			nodes[index/2] = Sha256Hash(nodes[index&^1].Bytes(), nodes[index|1].Bytes())
+			keys = append(keys, index/2)
+		}
+	}
+	return nodes[1]
+}
 
-	indices := p.genIndices
-	hashes := p.leavesHashes
-
-	head, tail, di := 0, len(indices), 0
-
-	for {
-		index := indices[head]
-		hash := hashes[head]
-		head++
+func (p *MerkleProof) GenIndex() int {
+	return p.genIndex
+}
 
-		if index == 1 {
-			return hash
-		} else if index&1 == 0 {
-			hash = Sha256Hash(hash.Bytes(), p.Decommitments[di].Bytes())
-			di++
-		} else if head != tail && indices[head] == index-1 {
-			hash = Sha256Hash(hashes[head].Bytes(), hash.Bytes())
-			head++
-		} else {
-			hash = Sha256Hash(p.Decommitments[di].Bytes(), hash.Bytes())
-			di++
-		}
-		indices = append(indices, index/2)
-		hashes = append(hashes, hash)
-		tail++
+// Verify checks that the proof of the given leaf reconstructs the root
+func (p *MerkleProof) Verify(leaf common.Hash, root common.Hash) error {
+	if p.genIndex>>len(p.Path) != 1 {
+		return fmt.Errorf("proof length %d doesn't match generalized index %d", len(p.Path), p.genIndex)
 	}
+	if res := p.ReconstructRoot(leaf); res != root {
+		return fmt.Errorf("proof of generalized index %d reconstructs root %s instead of %s", p.genIndex, res, root)
+	}
+	return nil
 }
 
 func (p *MerkleProof) ReconstructRoot(data common.Hash) common.Hash {
@@ -222,17 +269,3 @@ func HexToMerkleHash(s string) common.Hash {
 func BytesToMerkleHash(bs []byte) common.Hash {
 	return NewVectorMerkleTree(BytesToChunks(bs)...).Hash()
 }
-
-func merkle(chunks []common.Hash, n int) common.Hash {
-	if len(chunks) == 0 {
-		return ZeroHash(n)
-	}
-	if n == 1 {
-		return chunks[0]
-	}
-	m := n / 2
-	if len(chunks) <= m {
-		return Sha256Hash(merkle(chunks, m).Bytes(), ZeroHash(m).Bytes())
-	}
-	return Sha256Hash(merkle(chunks[:m], m).Bytes(), merkle(chunks[m:], m).Bytes())
-}
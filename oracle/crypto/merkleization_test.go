@@ -46,4 +46,152 @@ func TestMerkleMultiProof(t *testing.T) {
 
 	proof = tree.MakeMultiProof(nil)
 	assert.Equal(t, tree.Hash(), proof.ReconstructRoot())
+
+	indices := []int{3, 7, 15, 16, 17, 35, 87, 123, 124, 156, 199, 417, 483, 511}
+	assert.Equal(t, referenceMultiProof(leaves, 512, indices), tree.MakeMultiProof(indices).Decommitments)
+}
+
+func TestMerkleTreeMatchesReference(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 5, 8, 13, 100} {
+		leaves := make([]common.Hash, n)
+		for i := range leaves {
+			leaves[i] = UintToHash(uint64(i + 1))
+		}
+		for _, limit := range []int{CeilPow2(n), 128, 1 << 20} {
+			tree := NewListMerkleTree(leaves, limit)
+			expected := Sha256Hash(referenceMerkle(leaves, limit).Bytes(), UintToHash(uint64(n)).Bytes())
+			assert.Equal(t, expected, tree.Hash(), "%d leaves, limit %d", n, limit)
+			for i := range leaves {
+				proof := tree.MakeProof(i)
+				assert.Equal(t, referenceProof(leaves, limit, i), proof.Path[:len(proof.Path)-1], "%d leaves, limit %d, leaf %d", n, limit, i)
+				assert.NoError(t, proof.Verify(leaves[i], tree.Hash()))
+			}
+		}
+	}
+	assert.Equal(t, referenceZeroHash(1<<20), ZeroHash(1<<20))
+	assert.Equal(t, referenceZeroHash(3), ZeroHash(3))
+	assert.Equal(t, common.Hash{}, ZeroHash(0))
+}
+
+func TestMerkleTreeLargeLimit(t *testing.T) {
+	leaves := []common.Hash{UintToHash(1), UintToHash(2), UintToHash(3)}
+	tree := NewListMerkleTree(leaves, 1<<40)
+
+	proof := tree.MakeProof(2)
+	assert.Len(t, proof.Path, 41)
+	assert.Equal(t, 2+1<<41, proof.GenIndex())
+	assert.NoError(t, proof.Verify(leaves[2], tree.Hash()))
+
+	multiProof := tree.MakeMultiProof([]int{0, 2})
+	assert.Len(t, multiProof.Decommitments, 40)
+	assert.Equal(t, Sha256Hash(multiProof.ReconstructRoot().Bytes(), UintToHash(3).Bytes()), tree.Hash())
+}
+
+func BenchmarkMerkleTree(b *testing.B) {
+	leaves := make([]common.Hash, 1<<13)
+	for i := range leaves {
+		leaves[i] = UintToHash(uint64(i + 1))
+	}
+	indices := []int{3, 7, 15, 16, 17, 35, 87, 123, 124, 156, 199, 417, 483, 511, 4000, 8000}
+
+	b.Run("Hash", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewListMerkleTree(leaves, 1<<24).Hash()
+		}
+	})
+	b.Run("HashReference", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			referenceMerkle(leaves, 1<<24)
+		}
+	})
+	b.Run("MakeProof", func(b *testing.B) {
+		tree := NewListMerkleTree(leaves, 1<<24)
+		for i := 0; i < b.N; i++ {
+			tree.MakeProof(i % len(leaves))
+		}
+	})
+	b.Run("MakeProofReference", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			referenceProof(leaves, 1<<24, i%len(leaves))
+		}
+	})
+	b.Run("MakeMultiProof", func(b *testing.B) {
+		tree := NewVectorMerkleTree(leaves...)
+		for i := 0; i < b.N; i++ {
+			tree.MakeMultiProof(indices)
+		}
+	})
+	b.Run("MakeMultiProofReference", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			referenceMultiProof(leaves, len(leaves), indices)
+		}
+	})
+}
+
+// reference implementation, that hashes the subtrees recursively on every call
+
+func referenceZeroHash(n int) common.Hash {
+	res := common.Hash{}
+	for ; n > 1; n /= 2 {
+		res = Sha256Hash(res.Bytes(), res.Bytes())
+	}
+	return res
+}
+
+func referenceMerkle(chunks []common.Hash, n int) common.Hash {
+	if len(chunks) == 0 {
+		return referenceZeroHash(n)
+	}
+	if n == 1 {
+		return chunks[0]
+	}
+	m := n / 2
+	if len(chunks) <= m {
+		return Sha256Hash(referenceMerkle(chunks, m).Bytes(), referenceZeroHash(m).Bytes())
+	}
+	return Sha256Hash(referenceMerkle(chunks[:m], m).Bytes(), referenceMerkle(chunks[m:], m).Bytes())
+}
+
+func referenceProof(leaves []common.Hash, limit int, idx int) []common.Hash {
+	path := []common.Hash{}
+	for k := 1; k < limit; k *= 2 {
+		l := (idx/k ^ 1) * k
+		r := l + k
+		if l > len(leaves) {
+			l = len(leaves)
+		}
+		if r > len(leaves) {
+			r = len(leaves)
+		}
+		path = append(path, referenceMerkle(leaves[l:r], k))
+	}
+	return path
+}
+
+func referenceMultiProof(leaves []common.Hash, limit int, indices []int) []common.Hash {
+	var decommitments []common.Hash
+	known := make(map[int]bool, len(indices))
+	hashes := make(map[int]common.Hash, limit)
+	for i := 0; i < limit; i++ {
+		if i < len(leaves) {
+			hashes[i+limit] = leaves[i]
+		} else {
+			hashes[i+limit] = referenceZeroHash(0)
+		}
+	}
+	for _, index := range indices {
+		known[index+limit] = true
+	}
+	for i := limit*2 - 1; i > 1; i -= 2 {
+		left, right := known[i-1], known[i]
+		if left && !right {
+			decommitments = append(decommitments, hashes[i])
+		}
+		if !left && right {
+			decommitments = append(decommitments, hashes[i-1])
+		}
+		known[i/2] = left || right
+		hashes[i/2] = Sha256Hash(hashes[i-1].Bytes(), hashes[i].Bytes())
+	}
+	return decommitments
 }
@@ -0,0 +1,246 @@
+package crypto
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Merkleizer merkleizes consecutive values of the same schema, e.g. beacon states of neighbouring slots.
+// It keeps the layers of the previous value trees by field, together with the serialized fixed-size elements of sequences,
+// so that only the changed elements are merkleized and only the paths from the changed leaves are rehashed.
+type Merkleizer struct {
+	schema *SSZType
+
+	mu    sync.Mutex
+	cache *merkleCache
+}
+
+func NewMerkleizer(schema *SSZType) *Merkleizer {
+	return &Merkleizer{schema: schema, cache: &merkleCache{}}
+}
+
+// MerkleTree returns the same tree as SSZType.MerkleTree, reusing the nodes of the previously merkleized value
+func (m *Merkleizer) MerkleTree(v interface{}) (*MerkleTree, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tree, err := m.schema.merkleTreeCached(reflect.ValueOf(v), m.cache)
+	if err != nil {
+		// the cache may be partially updated
+		m.cache = &merkleCache{}
+		return nil, err
+	}
+	// the cached layers are updated in place by the next value
+	return tree.clone(), nil
+}
+
+// merkleCache holds the layers of the previous value tree, and either the caches of its container fields,
+// or the serialized fixed-size composite elements of its sequence. Trees of the elements themselves are not kept.
+type merkleCache struct {
+	limit  int
+	layers [][]common.Hash
+	fields []*merkleCache
+	// elements holds elementSize bytes per element, see SSZType.appendFixed
+	elements    []byte
+	elementSize int
+}
+
+func (c *merkleCache) field(i int) *merkleCache {
+	if c == nil {
+		return nil
+	}
+	for len(c.fields) <= i {
+		c.fields = append(c.fields, &merkleCache{})
+	}
+	return c.fields[i]
+}
+
+// leaves returns the leaves of the previous tree, if it has the same limit
+func (c *merkleCache) leaves(limit int) []common.Hash {
+	if c == nil || c.layers == nil || c.limit != limit {
+		return nil
+	}
+	return c.layers[0]
+}
+
+// update compares the leaves of the tree with the previous ones and rehashes the paths of the changed leaves
+func (c *merkleCache) update(tree *MerkleTree) *MerkleTree {
+	prev := c.leaves(tree.limit)
+	if prev == nil {
+		return c.rebuild(tree)
+	}
+	var dirty []int
+	for i := 0; i < len(prev) && i < len(tree.leaves); i++ {
+		if prev[i] != tree.leaves[i] {
+			dirty = append(dirty, i)
+		}
+	}
+	return c.rehash(tree, dirty, len(prev))
+}
+
+// rebuild hashes the whole tree and keeps its layers for the next value
+func (c *merkleCache) rebuild(tree *MerkleTree) *MerkleTree {
+	if c == nil {
+		return tree
+	}
+	tree.once.Do(tree.build)
+	c.limit = tree.limit
+	c.layers = tree.layers
+	return tree
+}
+
+// rehash replaces the leaves of the previous tree, which had prevLen leaves, with the leaves of the given tree.
+// Only the parents of the dirty leaves, as well as of the appended and removed ones, are rehashed, level by level in batches.
+func (c *merkleCache) rehash(tree *MerkleTree, dirty []int, prevLen int) *MerkleTree {
+	n := len(tree.leaves)
+	for i := prevLen; i < n; i++ {
+		dirty = append(dirty, i)
+	}
+	if n < prevLen && n > 0 && (len(dirty) == 0 || dirty[len(dirty)-1] != n-1) {
+		// the ancestors of the last leaf had the removed leaves on the right, now they have zero subtrees
+		dirty = append(dirty, n-1)
+	}
+
+	c.layers[0] = tree.leaves
+	var chunks, hashes []common.Hash
+	for level := 1; level < len(c.layers); level++ {
+		size := (len(c.layers[level-1]) + 1) / 2
+		layer := c.layers[level]
+		if len(layer) > size {
+			layer = layer[:size]
+		}
+		for len(layer) < size {
+			layer = append(layer, common.Hash{})
+		}
+		c.layers[level] = layer
+
+		// dirty indices are ascending, so are their parents
+		parents := dirty[:0]
+		for _, i := range dirty {
+			if p := i / 2; p < size && (len(parents) == 0 || parents[len(parents)-1] != p) {
+				parents = append(parents, p)
+			}
+		}
+		dirty = parents
+		if len(dirty) == 0 {
+			continue
+		}
+		chunks = chunks[:0]
+		if cap(hashes) < len(dirty) {
+			hashes = make([]common.Hash, len(dirty))
+		}
+		hashes = hashes[:len(dirty)]
+		for _, p := range dirty {
+			chunks = append(chunks, layerNode(c.layers, level-1, 2*p), layerNode(c.layers, level-1, 2*p+1))
+		}
+		hasher.HashPairs(hashes, chunks)
+		for i, p := range dirty {
+			layer[p] = hashes[i]
+		}
+	}
+
+	tree.layers = c.layers
+	tree.once.Do(func() {})
+	return tree
+}
+
+// clone copies the tree, so that it doesn't share the layers with the cache
+func (t *MerkleTree) clone() *MerkleTree {
+	t.once.Do(t.build)
+	layers := make([][]common.Hash, len(t.layers))
+	for i, layer := range t.layers {
+		layers[i] = append([]common.Hash(nil), layer...)
+	}
+	res := &MerkleTree{
+		isList: t.isList,
+		length: t.length,
+		limit:  t.limit,
+		leaves: layers[0],
+		layers: layers,
+	}
+	res.once.Do(func() {})
+	return res
+}
+
+// elementRootsCached returns the roots of the fixed-size sequence elements like elementRoots, only the elements,
+// which serialization differs from the previous value, are merkleized. It also returns the indices of the changed elements
+// and the length of the previous sequence, which is -1 if the previous tree can't be reused.
+func (t *SSZType) elementRootsCached(v reflect.Value, c *merkleCache, limit int) ([]common.Hash, []int, int, error) {
+	size, _ := t.fixedSize()
+	n := v.Len()
+	prev, prevLen, known := c.leaves(limit), -1, 0
+	if prev != nil && c.elementSize == size {
+		prevLen = len(prev)
+		known = len(c.elements) / size
+	}
+	if known > n {
+		known = n
+	}
+
+	leaves := prev
+	if prevLen < 0 || cap(leaves) < n {
+		leaves = make([]common.Hash, n)
+		copy(leaves, prev)
+	}
+	leaves = leaves[:n]
+	elements := c.elements
+	if cap(elements) < n*size {
+		elements = make([]byte, n*size)
+		copy(elements, c.elements[:known*size])
+	}
+	elements = elements[:n*size]
+
+	workers := hashWorkers()
+	if n < minParallelElements {
+		workers = 1
+	}
+	changed := make([]bool, n)
+	var mu sync.Mutex
+	var firstErr error
+	errIndex, unserialized := n, false
+	parallelFor(n, workers, func(from, to int) {
+		buf := make([]byte, 0, size)
+		for i := from; i < to; i++ {
+			var err error
+			buf, err = t.appendFixed(buf[:0], v.Index(i))
+			serialized := err == nil && len(buf) == size
+			if serialized && i < known && bytes.Equal(buf, elements[i*size:(i+1)*size]) {
+				continue
+			}
+			tree, err := t.merkleTree(v.Index(i))
+			mu.Lock()
+			if err != nil && i < errIndex {
+				errIndex, firstErr = i, fmt.Errorf("[%d]: %w", i, err)
+			}
+			unserialized = unserialized || !serialized
+			mu.Unlock()
+			if err != nil {
+				return
+			}
+			leaves[i] = tree.Hash()
+			changed[i] = true
+			if serialized {
+				copy(elements[i*size:], buf)
+			}
+		}
+	})
+	if firstErr != nil {
+		return nil, nil, 0, firstErr
+	}
+	c.elements, c.elementSize = elements, size
+	if unserialized {
+		// elements can't be compared with the next value
+		c.elements = nil
+	}
+
+	var dirty []int
+	for i := 0; i < prevLen && i < n; i++ {
+		if changed[i] {
+			dirty = append(dirty, i)
+		}
+	}
+	return leaves, dirty, prevLen, nil
+}
@@ -0,0 +1,107 @@
+package crypto
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingHasher counts two-to-one hashes
+type countingHasher struct {
+	Hasher
+	count int64
+}
+
+func (h *countingHasher) Hash(data ...[]byte) common.Hash {
+	atomic.AddInt64(&h.count, 1)
+	return h.Hasher.Hash(data...)
+}
+
+func (h *countingHasher) HashPairs(dst []common.Hash, chunks []common.Hash) {
+	atomic.AddInt64(&h.count, int64(len(dst)))
+	h.Hasher.HashPairs(dst, chunks)
+}
+
+func TestMerkleizer(t *testing.T) {
+	defer SetHasher(hasher)
+	counter := &countingHasher{Hasher: StdHasher}
+	SetHasher(counter)
+
+	type validator struct {
+		Pubkey  []byte
+		Balance uint64
+	}
+	type state struct {
+		Slot       uint64
+		Roots      [][]byte
+		Validators []*validator
+		Balances   []uint64
+	}
+	schema := NewSSZContainer(
+		NewSSZField("Slot", SSZUint64),
+		NewSSZField("Roots", NewSSZVector(SSZBytes32, 64)),
+		NewSSZField("Validators", NewSSZList(NewSSZContainer(
+			NewSSZField("Pubkey", NewSSZByteVector(48)),
+			NewSSZField("Balance", SSZUint64),
+		), 1<<20)),
+		NewSSZField("Balances", NewSSZList(SSZUint64, 1<<20)),
+	)
+	newState := func(slot uint64, n int) *state {
+		res := &state{Slot: slot, Roots: make([][]byte, 64)}
+		for i := range res.Roots {
+			res.Roots[i] = UintToHash(uint64(i)).Bytes()
+		}
+		for i := 0; i < n; i++ {
+			pubkey := append(UintToHash(uint64(i)).Bytes(), make([]byte, 16)...)
+			res.Validators = append(res.Validators, &validator{Pubkey: pubkey, Balance: uint64(i)})
+			res.Balances = append(res.Balances, uint64(i))
+		}
+		return res
+	}
+	m := NewMerkleizer(schema)
+	check := func(s *state) int64 {
+		expected, err := schema.HashTreeRoot(s)
+		require.NoError(t, err)
+		atomic.StoreInt64(&counter.count, 0)
+		tree, err := m.MerkleTree(s)
+		require.NoError(t, err)
+		assert.Equal(t, expected, tree.Hash())
+		return atomic.LoadInt64(&counter.count)
+	}
+
+	full := check(newState(1, 1000))
+	first, err := m.MerkleTree(newState(1, 1000))
+	require.NoError(t, err)
+	firstRoot := first.Hash()
+	assert.Less(t, check(newState(1, 1000)), int64(50))
+
+	// a few changed fields and elements are rehashed with their branches only
+	s := newState(2, 1000)
+	s.Roots[5] = common.Hash{1}.Bytes()
+	s.Validators[500].Balance++
+	s.Balances[700]++
+	assert.Less(t, check(s), full/10)
+
+	// appended and removed elements
+	assert.Less(t, check(newState(2, 1010)), full/10)
+	assert.Less(t, check(newState(2, 900)), full/10)
+	assert.Less(t, check(newState(2, 899)), full/10)
+	check(newState(2, 0))
+	check(newState(2, 1))
+	check(newState(2, 1000))
+	// the returned trees are not updated by the next values
+	assert.Equal(t, firstRoot, first.Hash())
+	// only the list-level layers and serialized elements are kept for validators
+	assert.Empty(t, m.cache.field(2).fields)
+	assert.Len(t, m.cache.field(2).elements, 1000*56)
+
+	// failed merkleization drops the cache
+	s = newState(3, 1000)
+	s.Validators = append(s.Validators, &validator{Pubkey: make([]byte, 47)})
+	_, err = m.MerkleTree(s)
+	assert.Error(t, err)
+	assert.Equal(t, full, check(newState(1, 1000)))
+}
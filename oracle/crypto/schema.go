@@ -0,0 +1,517 @@
+package crypto
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type SSZKind int
+
+const (
+	SSZUint SSZKind = iota
+	SSZBoolean
+	SSZVector
+	SSZList
+	SSZBitvector
+	SSZBitlist
+	SSZContainer
+)
+
+// SSZType describes the layout of an SSZ type, so that Go values with the same layout can be merkleized.
+// Containers are matched with Go structs by field names, vectors and lists with slices or arrays,
+// bitfields with byte slices in the SSZ encoding, and uints wider than 64 bits with little-endian byte slices.
+// Nil pointers and nil vector slices stand for zero values.
+type SSZType struct {
+	Kind SSZKind
+	// Size is the byte size of basic types
+	Size int
+	// Elem is the element type of vectors and lists
+	Elem *SSZType
+	// Length is the length of vectors and bitvectors, or the limit of lists and bitlists
+	Length int
+	Fields []SSZField
+}
+
+type SSZField struct {
+	Name string
+	Type *SSZType
+}
+
+var (
+	SSZUint8   = &SSZType{Kind: SSZUint, Size: 1}
+	SSZUint64  = &SSZType{Kind: SSZUint, Size: 8}
+	SSZUint256 = &SSZType{Kind: SSZUint, Size: 32}
+	SSZBool    = &SSZType{Kind: SSZBoolean, Size: 1}
+	SSZBytes32 = NewSSZByteVector(32)
+)
+
+func NewSSZVector(elem *SSZType, length int) *SSZType {
+	return &SSZType{Kind: SSZVector, Elem: elem, Length: length}
+}
+
+func NewSSZList(elem *SSZType, limit int) *SSZType {
+	return &SSZType{Kind: SSZList, Elem: elem, Length: limit}
+}
+
+func NewSSZByteVector(length int) *SSZType {
+	return NewSSZVector(SSZUint8, length)
+}
+
+func NewSSZByteList(limit int) *SSZType {
+	return NewSSZList(SSZUint8, limit)
+}
+
+func NewSSZBitvector(length int) *SSZType {
+	return &SSZType{Kind: SSZBitvector, Length: length}
+}
+
+func NewSSZBitlist(limit int) *SSZType {
+	return &SSZType{Kind: SSZBitlist, Length: limit}
+}
+
+func NewSSZContainer(fields ...SSZField) *SSZType {
+	return &SSZType{Kind: SSZContainer, Fields: fields}
+}
+
+func NewSSZField(name string, typ *SSZType) SSZField {
+	return SSZField{Name: name, Type: typ}
+}
+
+func (t *SSZType) isBasic() bool {
+	return t.Kind == SSZUint || t.Kind == SSZBoolean
+}
+
+// chunkLimit returns the number of chunks in the merkle tree of the type, before padding to the power of 2
+func (t *SSZType) chunkLimit() int {
+	switch t.Kind {
+	case SSZVector, SSZList:
+		if t.Elem.isBasic() {
+			return (t.Length*t.Elem.Size + 31) / 32
+		}
+		return t.Length
+	case SSZBitvector, SSZBitlist:
+		return (t.Length + 255) / 256
+	case SSZContainer:
+		return len(t.Fields)
+	default:
+		return 1
+	}
+}
+
+// HashTreeRoot returns the hash tree root of the given value
+func (t *SSZType) HashTreeRoot(v interface{}) (common.Hash, error) {
+	tree, err := t.MerkleTree(v)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return tree.Hash(), nil
+}
+
+// MerkleTree builds the merkle tree of the given value, its leaves are the roots of the container fields,
+// the roots of the composite elements, or the chunks of packed basic elements and bits
+func (t *SSZType) MerkleTree(v interface{}) (*MerkleTree, error) {
+	return t.merkleTree(reflect.ValueOf(v))
+}
+
+// GenIndex returns the generalized index of the node at the given path.
+// Path consists of field names for containers and indices for vectors, lists and bitfields,
+// indices of basic elements and bits point to the chunks containing them.
+// Field names are matched either exactly or in the snake case of the consensus specs, see ParseSSZPath.
+func (t *SSZType) GenIndex(path ...interface{}) (int, error) {
+	genIndex := 1
+	typ := t
+	for _, p := range path {
+		chunk, next, err := typ.child(p)
+		if err != nil {
+			return 0, err
+		}
+		genIndex = ConcatGenIndices(genIndex, typ.chunkGenIndex(chunk))
+		typ = next
+	}
+	return genIndex, nil
+}
+
+// child returns the chunk index and the type of the element with the given path element
+func (t *SSZType) child(p interface{}) (int, *SSZType, error) {
+	if t.Kind == SSZContainer {
+		name, ok := p.(string)
+		if !ok {
+			return 0, nil, fmt.Errorf("container field name expected, got %v", p)
+		}
+		for i, field := range t.Fields {
+			if field.Name == name || strings.EqualFold(field.Name, strings.ReplaceAll(name, "_", "")) {
+				return i, field.Type, nil
+			}
+		}
+		return 0, nil, fmt.Errorf("unknown container field %s", name)
+	}
+	if t.isBasic() {
+		return 0, nil, fmt.Errorf("can't descend into basic type with %v", p)
+	}
+	index, ok := p.(int)
+	if !ok {
+		return 0, nil, fmt.Errorf("element index expected, got %v", p)
+	}
+	if index < 0 || index >= t.Length {
+		return 0, nil, fmt.Errorf("index %d is out of range, length is %d", index, t.Length)
+	}
+	switch {
+	case t.Kind == SSZBitvector || t.Kind == SSZBitlist:
+		return index / 256, SSZBool, nil
+	case t.Elem.isBasic():
+		return index * t.Elem.Size / 32, t.Elem, nil
+	default:
+		return index, t.Elem, nil
+	}
+}
+
+func (t *SSZType) childValue(v reflect.Value, p interface{}) (reflect.Value, error) {
+	if t.Kind == SSZContainer {
+		index, _, err := t.child(p)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return v.FieldByName(t.Fields[index].Name), nil
+	}
+	if index := p.(int); index < v.Len() {
+		return v.Index(index), nil
+	}
+	return reflect.Value{}, fmt.Errorf("index %v is out of range, length is %d", p, v.Len())
+}
+
+// minParallelElements is the smallest number of composite elements, that are merkleized by several workers
+const minParallelElements = 256
+
+// elementRoots returns hash tree roots of the sequence elements, large sequences are split between hasher workers
+func (t *SSZType) elementRoots(v reflect.Value) ([]common.Hash, error) {
+	n := v.Len()
+	workers := hashWorkers()
+	if n < minParallelElements {
+		workers = 1
+	}
+	res := make([]common.Hash, n)
+	errs := make([]error, n)
+	parallelFor(n, workers, func(from, to int) {
+		for i := from; i < to; i++ {
+			tree, err := t.merkleTree(v.Index(i))
+			if err != nil {
+				errs[i] = fmt.Errorf("[%d]: %w", i, err)
+				return
+			}
+			res[i] = tree.Hash()
+		}
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// chunkGenIndex returns the generalized index of the chunk relative to the root of the type
+func (t *SSZType) chunkGenIndex(chunk int) int {
+	limit := CeilPow2(t.chunkLimit())
+	if t.Kind == SSZList || t.Kind == SSZBitlist {
+		// length is mixed in as the right sibling of the data root
+		return 2*limit + chunk
+	}
+	return limit + chunk
+}
+
+func (t *SSZType) merkleTree(v reflect.Value) (*MerkleTree, error) {
+	return t.merkleTreeCached(v, nil)
+}
+
+// merkleTreeCached merkleizes the value, reusing the unchanged nodes of the previous value trees from the cache, if it is not nil.
+// The returned tree shares the layers with the cache, so it is only valid until the next value is merkleized.
+func (t *SSZType) merkleTreeCached(v reflect.Value, cache *merkleCache) (*MerkleTree, error) {
+	v = deref(v)
+	if !v.IsValid() {
+		return nil, fmt.Errorf("missing value")
+	}
+	if (t.Kind == SSZVector || t.Kind == SSZBitvector) && v.Kind() == reflect.Slice && v.IsNil() {
+		n := t.Length
+		if t.Kind == SSZBitvector {
+			n = (t.Length + 7) / 8
+		}
+		v = reflect.MakeSlice(v.Type(), n, n)
+	}
+	switch t.Kind {
+	case SSZUint, SSZBoolean:
+		data, err := t.packBasic(v)
+		if err != nil {
+			return nil, err
+		}
+		return cache.update(NewPackedVectorMerkleTree(data)), nil
+	case SSZVector, SSZList:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil, fmt.Errorf("sequence expected, got %s", v.Type())
+		}
+		n := v.Len()
+		if t.Kind == SSZVector && n != t.Length {
+			return nil, fmt.Errorf("vector length should be %d, got %d", t.Length, n)
+		}
+		if t.Kind == SSZList && n > t.Length {
+			return nil, fmt.Errorf("list length %d exceeds limit %d", n, t.Length)
+		}
+		if t.Elem.isBasic() {
+			data, err := t.Elem.packSequence(v)
+			if err != nil {
+				return nil, err
+			}
+			if t.Kind == SSZVector {
+				return cache.update(NewPackedVectorMerkleTree(data)), nil
+			}
+			return cache.update(NewPackedListMerkleTree(data, n, CeilPow2(t.chunkLimit()))), nil
+		}
+		newTree := func(leaves []common.Hash) *MerkleTree {
+			if t.Kind == SSZVector {
+				return NewVectorMerkleTree(leaves...)
+			}
+			return NewListMerkleTree(leaves, CeilPow2(t.chunkLimit()))
+		}
+		if _, fixed := t.Elem.fixedSize(); cache != nil && fixed {
+			leaves, dirty, prevLen, err := t.Elem.elementRootsCached(v, cache, CeilPow2(t.chunkLimit()))
+			if err != nil {
+				return nil, err
+			}
+			if prevLen < 0 {
+				return cache.rebuild(newTree(leaves)), nil
+			}
+			return cache.rehash(newTree(leaves), dirty, prevLen), nil
+		}
+		leaves, err := t.Elem.elementRoots(v)
+		if err != nil {
+			return nil, err
+		}
+		return cache.update(newTree(leaves)), nil
+	case SSZBitvector:
+		data, ok := byteSlice(v)
+		if !ok {
+			return nil, fmt.Errorf("bitvector bytes expected, got %s", v.Type())
+		}
+		if len(data) != (t.Length+7)/8 {
+			return nil, fmt.Errorf("bitvector of %d bits should have %d bytes, got %d", t.Length, (t.Length+7)/8, len(data))
+		}
+		return cache.update(NewPackedVectorMerkleTree(data)), nil
+	case SSZBitlist:
+		data, ok := byteSlice(v)
+		if !ok {
+			return nil, fmt.Errorf("bitlist bytes expected, got %s", v.Type())
+		}
+		length := 0
+		if len(data) > 0 {
+			last := data[len(data)-1]
+			if last == 0 {
+				return nil, fmt.Errorf("bitlist has no delimiter bit")
+			}
+			length = 8*(len(data)-1) + bits.Len8(last) - 1
+			data = append([]byte{}, data[:(length+7)/8]...)
+			if length%8 > 0 {
+				data[len(data)-1] &= 1<<(length%8) - 1
+			}
+		}
+		if length > t.Length {
+			return nil, fmt.Errorf("bitlist length %d exceeds limit %d", length, t.Length)
+		}
+		return cache.update(NewPackedListMerkleTree(data, length, CeilPow2(t.chunkLimit()))), nil
+	case SSZContainer:
+		if v.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("struct expected, got %s", v.Type())
+		}
+		leaves := make([]common.Hash, len(t.Fields))
+		for i, field := range t.Fields {
+			f := v.FieldByName(field.Name)
+			if !f.IsValid() {
+				return nil, fmt.Errorf("field %s is missing in %s", field.Name, v.Type())
+			}
+			tree, err := field.Type.merkleTreeCached(f, cache.field(i))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", field.Name, err)
+			}
+			leaves[i] = tree.Hash()
+		}
+		return cache.update(NewVectorMerkleTree(leaves...)), nil
+	default:
+		return nil, fmt.Errorf("unknown ssz kind %d", t.Kind)
+	}
+}
+
+// packBasic serializes the basic value into Size bytes
+func (t *SSZType) packBasic(v reflect.Value) ([]byte, error) {
+	return t.appendBasic(make([]byte, 0, t.Size), v)
+}
+
+// appendBasic appends Size bytes of the serialized basic value
+func (t *SSZType) appendBasic(buf []byte, v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if t.Kind != SSZBoolean {
+			return nil, fmt.Errorf("uint%d expected, got bool", 8*t.Size)
+		}
+		if v.Bool() {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		if t.Kind != SSZUint {
+			return nil, fmt.Errorf("bool expected, got %s", v.Type())
+		}
+		x := v.Uint()
+		if t.Size < 8 && x>>(8*t.Size) > 0 {
+			return nil, fmt.Errorf("value %d overflows uint%d", x, 8*t.Size)
+		}
+		var data [32]byte
+		binary.LittleEndian.PutUint64(data[:], x)
+		return append(buf, data[:t.Size]...), nil
+	}
+	if data, ok := byteSlice(v); ok && t.Kind == SSZUint {
+		if len(data) != t.Size {
+			return nil, fmt.Errorf("uint%d should have %d bytes, got %d", 8*t.Size, t.Size, len(data))
+		}
+		return append(buf, data...), nil
+	}
+	return nil, fmt.Errorf("unexpected %s value for basic type", v.Type())
+}
+
+// fixedSize returns the size of the serialized values, if it is the same for all values of the type
+func (t *SSZType) fixedSize() (int, bool) {
+	switch t.Kind {
+	case SSZUint, SSZBoolean:
+		return t.Size, true
+	case SSZBitvector:
+		return (t.Length + 7) / 8, true
+	case SSZVector:
+		size, ok := t.Elem.fixedSize()
+		return size * t.Length, ok
+	case SSZContainer:
+		res := 0
+		for _, field := range t.Fields {
+			size, ok := field.Type.fixedSize()
+			if !ok {
+				return 0, false
+			}
+			res += size
+		}
+		return res, true
+	default:
+		return 0, false
+	}
+}
+
+// appendFixed appends the SSZ serialization of the fixed-size value, values of unexpected lengths are rejected,
+// so that equal serializations mean equal hash tree roots
+func (t *SSZType) appendFixed(buf []byte, v reflect.Value) ([]byte, error) {
+	v = deref(v)
+	if !v.IsValid() {
+		return nil, fmt.Errorf("missing value")
+	}
+	switch t.Kind {
+	case SSZUint, SSZBoolean:
+		return t.appendBasic(buf, v)
+	case SSZVector, SSZBitvector:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			size, _ := t.fixedSize()
+			return append(buf, make([]byte, size)...), nil
+		}
+		n := t.Length
+		if t.Kind == SSZBitvector {
+			n = (t.Length + 7) / 8
+		}
+		if data, ok := byteSlice(v); ok && (t.Kind == SSZBitvector || t.Elem.Size == 1) {
+			if len(data) != n {
+				return nil, fmt.Errorf("%d bytes expected, got %d", n, len(data))
+			}
+			return append(buf, data...), nil
+		}
+		if t.Kind == SSZBitvector || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) {
+			return nil, fmt.Errorf("unexpected %s value for sequence", v.Type())
+		}
+		if v.Len() != n {
+			return nil, fmt.Errorf("vector length should be %d, got %d", n, v.Len())
+		}
+		var err error
+		for i := 0; i < n; i++ {
+			if buf, err = t.Elem.appendFixed(buf, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case SSZContainer:
+		if v.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("struct expected, got %s", v.Type())
+		}
+		var err error
+		for _, field := range t.Fields {
+			f := v.FieldByName(field.Name)
+			if !f.IsValid() {
+				return nil, fmt.Errorf("field %s is missing in %s", field.Name, v.Type())
+			}
+			if buf, err = field.Type.appendFixed(buf, f); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	default:
+		return nil, fmt.Errorf("ssz kind %d has no fixed size", t.Kind)
+	}
+}
+
+// packSequence serializes the sequence of basic values
+func (t *SSZType) packSequence(v reflect.Value) ([]byte, error) {
+	if data, ok := byteSlice(v); ok && t.Size == 1 {
+		return data, nil
+	}
+	res := make([]byte, 0, v.Len()*t.Size)
+	for i := 0; i < v.Len(); i++ {
+		data, err := t.packBasic(deref(v.Index(i)))
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		res = append(res, data...)
+	}
+	return res, nil
+}
+
+// deref follows pointers, nil pointers are replaced with zero values
+func deref(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			if v.Kind() == reflect.Interface {
+				return reflect.Value{}
+			}
+			return reflect.Zero(v.Type().Elem())
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func byteSlice(v reflect.Value) ([]byte, bool) {
+	if (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || v.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
+	}
+	if v.Kind() == reflect.Slice {
+		return v.Bytes(), true
+	}
+	res := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(res), v)
+	return res, true
+}
+
+// ConcatGenIndices mirrors concat_generalized_indices from the consensus specs,
+// each index is relative to the node at the previous one
+func ConcatGenIndices(indices ...int) int {
+	res := 1
+	for _, index := range indices {
+		depth := bits.Len(uint(index)) - 1
+		res = res<<depth | (index ^ 1<<depth)
+	}
+	return res
+}
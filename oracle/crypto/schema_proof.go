@@ -0,0 +1,181 @@
+package crypto
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ParseSSZPath splits the dot separated path, e.g. "latest_execution_payload_header.receipts_root" or "state_roots.5",
+// into the path elements accepted by SSZType.GenIndex, numeric elements become indices
+func ParseSSZPath(path string) []interface{} {
+	if path == "" {
+		return nil
+	}
+	var res []interface{}
+	for _, p := range strings.Split(path, ".") {
+		if index, err := strconv.Atoi(p); err == nil {
+			res = append(res, index)
+		} else {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// Prove makes a merkle proof for the node at the given path of the value, see GenIndex for the path format.
+// It returns the proven node as well, the proof is verified against the root of the value.
+func (t *SSZType) Prove(v interface{}, path ...interface{}) (*MerkleProof, common.Hash, error) {
+	return t.ProveFromTree(nil, v, path...)
+}
+
+// ProveFromTree works as Prove, but reuses the given merkle tree of the value, if it is not nil
+func (t *SSZType) ProveFromTree(tree *MerkleTree, v interface{}, path ...interface{}) (*MerkleProof, common.Hash, error) {
+	var err error
+	if tree == nil {
+		if tree, err = t.MerkleTree(v); err != nil {
+			return nil, common.Hash{}, err
+		}
+	}
+	proof, leaf, err := t.prove(tree, reflect.ValueOf(v), path)
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("can't prove %v: %w", path, err)
+	}
+	if err = proof.Verify(leaf, tree.Hash()); err != nil {
+		return nil, common.Hash{}, fmt.Errorf("can't prove %v: %w", path, err)
+	}
+	return proof, leaf, nil
+}
+
+// ProveMulti makes a merkle multiproof for the nodes at the given paths of the value,
+// the proof is verified against the root of the value
+func (t *SSZType) ProveMulti(v interface{}, paths ...[]interface{}) (*MerkleMultiProof, error) {
+	return t.ProveMultiFromTree(nil, v, paths...)
+}
+
+// ProveMultiFromTree works as ProveMulti, but reuses the given merkle tree of the value, if it is not nil
+func (t *SSZType) ProveMultiFromTree(tree *MerkleTree, v interface{}, paths ...[]interface{}) (*MerkleMultiProof, error) {
+	var err error
+	if tree == nil {
+		if tree, err = t.MerkleTree(v); err != nil {
+			return nil, err
+		}
+	}
+	res := &MerkleMultiProof{}
+	if len(paths) == 0 {
+		res.Decommitments = []common.Hash{tree.Hash()}
+		return res, nil
+	}
+	// siblings of all nodes on the paths, decommitments are picked from them
+	siblings := make(map[int]common.Hash)
+	for _, path := range paths {
+		proof, leaf, err := t.prove(tree, reflect.ValueOf(v), path)
+		if err != nil {
+			return nil, fmt.Errorf("can't prove %v: %w", path, err)
+		}
+		res.genIndices = append(res.genIndices, proof.genIndex)
+		res.leavesHashes = append(res.leavesHashes, leaf)
+		for i, h := range proof.Path {
+			siblings[(proof.genIndex>>i)^1] = h
+		}
+	}
+	for i, a := range res.genIndices {
+		for _, b := range res.genIndices[:i] {
+			if isAncestor(a, b) || isAncestor(b, a) {
+				return nil, fmt.Errorf("paths with generalized indices %d and %d overlap", b, a)
+			}
+		}
+	}
+	for _, index := range HelperIndices(res.genIndices) {
+		res.Decommitments = append(res.Decommitments, siblings[index])
+	}
+	if root := res.ReconstructRoot(); root != tree.Hash() {
+		return nil, fmt.Errorf("multiproof of %v reconstructs root %s instead of %s", paths, root, tree.Hash())
+	}
+	return res, nil
+}
+
+func (t *SSZType) prove(tree *MerkleTree, v reflect.Value, path []interface{}) (*MerkleProof, common.Hash, error) {
+	res := &MerkleProof{genIndex: 1}
+	leaf := tree.Hash()
+	typ := t
+	var levels [][]common.Hash
+	for i, p := range path {
+		chunk, next, err := typ.child(p)
+		if err != nil {
+			return nil, common.Hash{}, err
+		}
+		if i > 0 {
+			if tree, err = typ.merkleTree(v); err != nil {
+				return nil, common.Hash{}, err
+			}
+		}
+		if typ.Kind == SSZVector || typ.Kind == SSZList {
+			if n := deref(v).Len(); p.(int) >= n {
+				return nil, common.Hash{}, fmt.Errorf("index %v is out of range, length is %d", p, n)
+			}
+		}
+		if chunk >= len(tree.leaves) {
+			return nil, common.Hash{}, fmt.Errorf("index %v is out of range", p)
+		}
+		proof := tree.MakeProof(chunk)
+		res.genIndex = ConcatGenIndices(res.genIndex, proof.genIndex)
+		levels = append(levels, proof.Path)
+		leaf = tree.leaves[chunk]
+
+		if i+1 < len(path) {
+			if v, err = typ.childValue(deref(v), p); err != nil {
+				return nil, common.Hash{}, err
+			}
+		}
+		typ = next
+	}
+	for i := len(levels) - 1; i >= 0; i-- {
+		res.Path = append(res.Path, levels[i]...)
+	}
+	return res, leaf, nil
+}
+
+// ConcatProofs joins the proofs of nested objects, starting with the outermost one,
+// so that the resulting generalized index is concat_generalized_indices of the given ones
+func ConcatProofs(proofs ...*MerkleProof) *MerkleProof {
+	res := &MerkleProof{genIndex: 1}
+	for _, proof := range proofs {
+		res.genIndex = ConcatGenIndices(res.genIndex, proof.genIndex)
+	}
+	for i := len(proofs) - 1; i >= 0; i-- {
+		res.Path = append(res.Path, proofs[i].Path...)
+	}
+	return res
+}
+
+// HelperIndices returns generalized indices of the nodes required to reconstruct the root from the given ones,
+// in the decreasing order, as get_helper_indices from the consensus specs
+func HelperIndices(genIndices []int) []int {
+	branch := make(map[int]bool)
+	onPath := make(map[int]bool)
+	for _, index := range genIndices {
+		for ; index > 1; index /= 2 {
+			branch[index^1] = true
+			onPath[index] = true
+		}
+	}
+	var res []int
+	for index := range branch {
+		if !onPath[index] {
+			res = append(res, index)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(res)))
+	return res
+}
+
+func isAncestor(ancestor, index int) bool {
+	for ; index > ancestor; index /= 2 {
+	}
+	return index == ancestor
+}
@@ -0,0 +1,187 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/forks"
+)
+
+var (
+	testCheckpointSchema = NewSSZContainer(
+		NewSSZField("Epoch", SSZUint64),
+		NewSSZField("Root", SSZBytes32),
+	)
+	testAttestationDataSchema = NewSSZContainer(
+		NewSSZField("Slot", SSZUint64),
+		NewSSZField("Index", SSZUint64),
+		NewSSZField("BeaconBlockRoot", SSZBytes32),
+		NewSSZField("Source", testCheckpointSchema),
+		NewSSZField("Target", testCheckpointSchema),
+	)
+	testAttestationSchema = NewSSZContainer(
+		NewSSZField("AggregationBits", NewSSZBitlist(2048)),
+		NewSSZField("Data", testAttestationDataSchema),
+		NewSSZField("Signature", NewSSZByteVector(96)),
+	)
+	testIndexedAttestationSchema = NewSSZContainer(
+		NewSSZField("AttestingIndices", NewSSZList(SSZUint64, 2048)),
+		NewSSZField("Data", testAttestationDataSchema),
+		NewSSZField("Signature", NewSSZByteVector(96)),
+	)
+)
+
+func newTestAttestationData() *forks.AttestationData {
+	return &forks.AttestationData{
+		Slot:            10,
+		Index:           2,
+		BeaconBlockRoot: common.Hash{1}.Bytes(),
+		Source:          &forks.Checkpoint{Epoch: 1, Root: common.Hash{2}.Bytes()},
+		Target:          &forks.Checkpoint{Epoch: 2, Root: common.Hash{3}.Bytes()},
+	}
+}
+
+func TestSSZHashTreeRoot(t *testing.T) {
+	for _, bits := range [][]byte{{0x01}, {0x05}, {0xff, 0x01}, append(make([]byte, 255), 0x80)} {
+		attestation := &forks.Attestation{AggregationBits: bits, Data: newTestAttestationData(), Signature: make([]byte, 96)}
+		root, err := testAttestationSchema.HashTreeRoot(attestation)
+		require.NoError(t, err)
+		assert.Equal(t, MustHashTreeRoot(attestation), root, "%x", bits)
+	}
+
+	indexed := &forks.IndexedAttestation{Data: newTestAttestationData(), Signature: make([]byte, 96)}
+	for i := uint64(0); i < 9; i++ {
+		indexed.AttestingIndices = append(indexed.AttestingIndices, i*100)
+		root, err := testIndexedAttestationSchema.HashTreeRoot(indexed)
+		require.NoError(t, err)
+		assert.Equal(t, MustHashTreeRoot(indexed), root, "%d indices", i+1)
+	}
+
+	// nil containers and vectors are merkleized as zero values
+	root, err := testAttestationDataSchema.HashTreeRoot(&forks.AttestationData{})
+	require.NoError(t, err)
+	assert.Equal(t, MustHashTreeRoot(&forks.AttestationData{
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &forks.Checkpoint{Root: make([]byte, 32)},
+		Target:          &forks.Checkpoint{Root: make([]byte, 32)},
+	}), root)
+
+	// arrays and uints of other sizes
+	root, err = NewSSZVector(NewSSZByteVector(4), 2).HashTreeRoot([][4]byte{{1}, {2}})
+	require.NoError(t, err)
+	assert.Equal(t, NewVectorMerkleTree(common.Hash{1}, common.Hash{2}).Hash(), root)
+	root, err = NewSSZList(&SSZType{Kind: SSZUint, Size: 2}, 100).HashTreeRoot([]uint16{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, NewPackedListMerkleTree([]byte{1, 0, 2, 0}, 2, 8).Hash(), root)
+}
+
+func TestSSZErrors(t *testing.T) {
+	_, err := testCheckpointSchema.HashTreeRoot(&forks.Checkpoint{Root: make([]byte, 31)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Root: vector length should be 32, got 31")
+
+	_, err = NewSSZList(SSZUint64, 2).HashTreeRoot([]uint64{1, 2, 3})
+	assert.Error(t, err)
+	_, err = NewSSZList(SSZUint8, 10).HashTreeRoot([]uint64{256})
+	assert.Error(t, err)
+	_, err = NewSSZBitlist(8).HashTreeRoot([]byte{0xff, 0x03})
+	assert.Error(t, err)
+	_, err = NewSSZBitlist(8).HashTreeRoot([]byte{0xff, 0x00})
+	assert.Error(t, err)
+
+	_, err = NewSSZContainer(NewSSZField("Epoch", SSZUint64), NewSSZField("Unknown", SSZUint64)).HashTreeRoot(&forks.Checkpoint{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "field Unknown is missing")
+}
+
+func TestSSZProve(t *testing.T) {
+	indexed := &forks.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2, 3, 4, 5, 6, 7},
+		Data:             newTestAttestationData(),
+		Signature:        make([]byte, 96),
+	}
+	root := MustHashTreeRoot(indexed)
+
+	for _, test := range []struct {
+		path     []interface{}
+		genIndex int
+		leaf     common.Hash
+	}{
+		{[]interface{}{"Data"}, 5, MustHashTreeRoot(indexed.Data)},
+		{[]interface{}{"Data", "Target", "Root"}, (5*8+4)*2 + 1, common.Hash{3}},
+		// 4 uint64 values are packed into a chunk, list length is mixed in
+		{[]interface{}{"AttestingIndices", 5}, 4*2*512 + 1, NewPackedVectorMerkleTree([]byte{5, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 7}).Leaves()[0]},
+	} {
+		genIndex, err := testIndexedAttestationSchema.GenIndex(test.path...)
+		require.NoError(t, err)
+		assert.Equal(t, test.genIndex, genIndex, "%v", test.path)
+
+		proof, leaf, err := testIndexedAttestationSchema.Prove(indexed, test.path...)
+		require.NoError(t, err)
+		assert.Equal(t, test.genIndex, proof.GenIndex(), "%v", test.path)
+		assert.Equal(t, test.leaf, leaf, "%v", test.path)
+		assert.Equal(t, root, proof.ReconstructRoot(test.leaf), "%v", test.path)
+	}
+
+	_, _, err := testIndexedAttestationSchema.Prove(indexed, "AttestingIndices", 8)
+	assert.Error(t, err)
+	_, err = testIndexedAttestationSchema.GenIndex("Data", "Slot", 0)
+	assert.Error(t, err)
+	_, err = testIndexedAttestationSchema.GenIndex("Data", 0)
+	assert.Error(t, err)
+}
+
+func TestSSZPathProofs(t *testing.T) {
+	indexed := &forks.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2, 3, 4, 5, 6, 7},
+		Data:             newTestAttestationData(),
+		Signature:        make([]byte, 96),
+	}
+	root := MustHashTreeRoot(indexed)
+
+	assert.Equal(t, []interface{}{"data", "target", "root"}, ParseSSZPath("data.target.root"))
+	assert.Equal(t, []interface{}{"attesting_indices", 5}, ParseSSZPath("attesting_indices.5"))
+	proof, leaf, err := testIndexedAttestationSchema.Prove(indexed, ParseSSZPath("data.target.root")...)
+	require.NoError(t, err)
+	assert.Equal(t, common.Hash{3}, leaf)
+	assert.NoError(t, proof.Verify(leaf, root))
+	assert.Error(t, proof.Verify(common.Hash{4}, root))
+
+	// proofs of nodes at different depths
+	paths := [][]interface{}{
+		ParseSSZPath("data.target.root"),
+		ParseSSZPath("data.slot"),
+		ParseSSZPath("attesting_indices.6"),
+		ParseSSZPath("signature"),
+	}
+	multiProof, err := testIndexedAttestationSchema.ProveMulti(indexed, paths...)
+	require.NoError(t, err)
+	assert.Equal(t, root, multiProof.ReconstructRoot())
+	assert.Len(t, multiProof.Leaves(), len(paths))
+	for i, path := range paths {
+		genIndex, err := testIndexedAttestationSchema.GenIndex(path...)
+		require.NoError(t, err)
+		assert.Equal(t, genIndex, multiProof.GenIndices()[i], "%v", path)
+	}
+	restored := NewMerkleMultiProof(multiProof.GenIndices(), multiProof.Leaves(), multiProof.Decommitments)
+	assert.Equal(t, root, restored.ReconstructRoot())
+
+	_, err = testIndexedAttestationSchema.ProveMulti(indexed, ParseSSZPath("data"), ParseSSZPath("data.slot"))
+	assert.Error(t, err)
+
+	// concatenation of the proofs for nested objects
+	dataProof, dataRoot, err := testIndexedAttestationSchema.Prove(indexed, "data")
+	require.NoError(t, err)
+	targetProof, _, err := testAttestationDataSchema.Prove(indexed.Data, "target", "root")
+	require.NoError(t, err)
+	assert.Equal(t, dataRoot, MustHashTreeRoot(indexed.Data))
+	concat := ConcatProofs(dataProof, targetProof)
+	assert.Equal(t, proof.GenIndex(), concat.GenIndex())
+	assert.Equal(t, proof.Path, concat.Path)
+	assert.Equal(t, proof.GenIndex(), ConcatGenIndices(dataProof.GenIndex(), targetProof.GenIndex()))
+	assert.Equal(t, 1, ConcatGenIndices())
+	assert.Equal(t, 13, ConcatGenIndices(3, 1, 2, 3))
+}
@@ -2,16 +2,26 @@ package crypto
 
 import (
 	"encoding/binary"
+	"math/bits"
 
 	"github.com/ethereum/go-ethereum/common"
 )
 
-func ZeroHash(n int) common.Hash {
-	res := common.Hash{}
-	for ; n > 1; n /= 2 {
-		res = Sha256Hash(res.Bytes(), res.Bytes())
+// zeroHashes holds roots of zero subtrees by their depth
+var zeroHashes = func() []common.Hash {
+	res := make([]common.Hash, 64)
+	for i := 1; i < len(res); i++ {
+		res[i] = Sha256Hash(res[i-1].Bytes(), res[i-1].Bytes())
 	}
 	return res
+}()
+
+// ZeroHash returns the root of the zero subtree with n leaves
+func ZeroHash(n int) common.Hash {
+	if n <= 1 {
+		return common.Hash{}
+	}
+	return zeroHashes[bits.Len(uint(n))-1]
 }
 
 func CeilPow2(n int) int {
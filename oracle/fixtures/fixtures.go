@@ -0,0 +1,269 @@
+// Package fixtures records HTTP interactions of the beacon and execution clients into a directory,
+// and replays them later, so that the code talking to both networks can be tested offline.
+package fixtures
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"oracle/config"
+)
+
+// Fixture is a single recorded request together with its response
+type Fixture struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Body       []byte      `json:"body,omitempty"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Response   []byte      `json:"response"`
+}
+
+var (
+	recordersMu sync.Mutex
+	recorders   = make(map[string]*Recorder)
+)
+
+// NewTransport returns a replaying or a recording transport if configured, or the default transport otherwise.
+// Recorders are shared by directory, so that clients of several endpoints don't overwrite each other's fixtures,
+// such fixtures are replayed by a single client in the recorded order.
+func NewTransport(cfg config.HTTPClientConfig) http.RoundTripper {
+	if cfg.ReplayDir != "" {
+		return NewReplayer(cfg.ReplayDir)
+	}
+	if cfg.RecordDir != "" {
+		dir := filepath.Clean(cfg.RecordDir)
+		recordersMu.Lock()
+		defer recordersMu.Unlock()
+		if recorders[dir] == nil {
+			recorders[dir] = NewRecorder(dir, http.DefaultTransport)
+		}
+		return recorders[dir]
+	}
+	return http.DefaultTransport
+}
+
+// DialRPC connects to the execution client, recording or replaying its traffic over http if configured.
+// Otherwise any transport supported by rpc.DialContext can be used, e.g. ws:// or IPC.
+func DialRPC(ctx context.Context, cfg config.HTTPClientConfig) (*rpc.Client, error) {
+	var url string
+	if endpoints := cfg.Endpoints(); len(endpoints) > 0 {
+		url = endpoints[0]
+	}
+	if cfg.RecordDir == "" && cfg.ReplayDir == "" {
+		return rpc.DialContext(ctx, url)
+	}
+	if url == "" && cfg.ReplayDir != "" {
+		url = "http://replay"
+	}
+	return rpc.DialHTTPWithClient(url, &http.Client{Transport: NewTransport(cfg)})
+}
+
+// Recorder is an http.RoundTripper, which saves all successfully completed interactions into the directory.
+// Repeated identical requests are saved into separate numbered fixtures, so that replay preserves their order.
+type Recorder struct {
+	dir   string
+	inner http.RoundTripper
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func NewRecorder(dir string, inner http.RoundTripper) *Recorder {
+	return &Recorder{dir: dir, inner: inner, counts: make(map[string]int)}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.inner.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read response body: %w", err)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(data))
+
+	key := fixtureKey(req, body)
+	r.mu.Lock()
+	n := r.counts[key]
+	r.counts[key]++
+	r.mu.Unlock()
+
+	fixture := &Fixture{
+		Method:     req.Method,
+		URL:        req.URL.RequestURI(),
+		Body:       body,
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Response:   data,
+	}
+	if err = writeFixture(filepath.Join(r.dir, fmt.Sprintf("%s.%d.json", key, n)), fixture); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Replayer is an http.RoundTripper, which serves responses from the fixtures saved by Recorder.
+// When a request is repeated more times than it was recorded, the last recorded response is served again.
+type Replayer struct {
+	dir string
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func NewReplayer(dir string) *Replayer {
+	return &Replayer{dir: dir, counts: make(map[string]int)}
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	key := fixtureKey(req, body)
+	r.mu.Lock()
+	n := r.counts[key]
+	r.counts[key]++
+	r.mu.Unlock()
+
+	fixture, err := readFixture(filepath.Join(r.dir, fmt.Sprintf("%s.%d.json", key, n)))
+	for ; os.IsNotExist(err) && n > 0; n-- {
+		fixture, err = readFixture(filepath.Join(r.dir, fmt.Sprintf("%s.%d.json", key, n-1)))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("no fixture for %s %s: %w", req.Method, req.URL.RequestURI(), err)
+	}
+
+	response := fixture.Response
+	if id, ok := jsonRPCID(body); ok {
+		// request ids depend on the number of calls made by the rpc client, so they are patched in the replayed response
+		var msg map[string]json.RawMessage
+		if json.Unmarshal(response, &msg) == nil {
+			msg["id"] = id
+			response, _ = json.Marshal(msg)
+		}
+	}
+	header := fixture.Header.Clone()
+	header.Del("Content-Length")
+	return &http.Response{
+		Status:        http.StatusText(fixture.StatusCode),
+		StatusCode:    fixture.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(response)),
+		ContentLength: int64(len(response)),
+		Request:       req,
+	}, nil
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read request body: %w", err)
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+var unsafeChars = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// fixtureKey identifies the request regardless of the host, so that the same fixtures can be served for any url.
+// The key starts with a readable part of the path, followed by the hash of the whole request.
+func fixtureKey(req *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(req.Method + " " + req.URL.RequestURI() + "\n"))
+	h.Write(normalizeJSONRPC(body))
+	name := strings.Trim(unsafeChars.ReplaceAllString(req.URL.Path, "_"), "_")
+	if method, ok := jsonRPCMethod(body); ok {
+		name = strings.Trim(name+"_"+method, "_")
+	}
+	if len(name) > 80 {
+		name = name[len(name)-80:]
+	}
+	return name + "-" + hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+type jsonRPCMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// normalizeJSONRPC drops the request id from the JSON-RPC request body
+func normalizeJSONRPC(body []byte) []byte {
+	var msg jsonRPCMessage
+	if len(body) == 0 || json.Unmarshal(body, &msg) != nil || msg.Method == "" {
+		return body
+	}
+	msg.ID = nil
+	res, _ := json.Marshal(msg)
+	return res
+}
+
+func jsonRPCMethod(body []byte) (string, bool) {
+	var msg jsonRPCMessage
+	if len(body) == 0 || json.Unmarshal(body, &msg) != nil || msg.Method == "" {
+		return "", false
+	}
+	return msg.Method, true
+}
+
+func jsonRPCID(body []byte) (json.RawMessage, bool) {
+	var msg jsonRPCMessage
+	if len(body) == 0 || json.Unmarshal(body, &msg) != nil || msg.Method == "" || msg.ID == nil {
+		return nil, false
+	}
+	return msg.ID, true
+}
+
+func writeFixture(path string, fixture *Fixture) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("can't create fixtures directory: %w", err)
+	}
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't marshal fixture: %w", err)
+	}
+	if err = os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("can't write fixture: %w", err)
+	}
+	return nil
+}
+
+func readFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fixture := new(Fixture)
+	if err = json.Unmarshal(data, fixture); err != nil {
+		return nil, fmt.Errorf("can't parse fixture %s: %w", path, err)
+	}
+	return fixture, nil
+}
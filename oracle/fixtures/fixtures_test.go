@@ -0,0 +1,123 @@
+package fixtures_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/beaconclient"
+	"oracle/config"
+	"oracle/fixtures"
+)
+
+func TestRecordReplayBeacon(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/eth/v1/beacon/blocks/head/root":
+			fmt.Fprintf(w, `{"data":{"root":"%s"}}`, common.Hash{byte(calls)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	recording := beaconclient.NewClient(srv.URL, config.HTTPClientConfig{RecordDir: dir})
+	for i := 1; i <= 2; i++ {
+		root, err := recording.GetBlockRoot(ctx, "head")
+		require.NoError(t, err)
+		assert.Equal(t, common.Hash{byte(i)}, root)
+	}
+	_, err := recording.GetBlockRoot(ctx, "1")
+	assert.ErrorIs(t, err, beaconclient.NotFoundError)
+	srv.Close()
+
+	replaying := beaconclient.NewReplayClient(dir)
+	// responses are served in the recorded order, the last one is repeated
+	for _, expected := range []common.Hash{{1}, {2}, {2}} {
+		root, err := replaying.GetBlockRoot(ctx, "head")
+		require.NoError(t, err)
+		assert.Equal(t, expected, root)
+	}
+	_, err = replaying.GetBlockRoot(ctx, "1")
+	assert.ErrorIs(t, err, beaconclient.NotFoundError)
+	_, err = replaying.GetBlockRoot(ctx, "2")
+	assert.Error(t, err)
+}
+
+func TestRecordSeveralEndpoints(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	var clients []beaconclient.Eth2Client
+	for i := 1; i <= 2; i++ {
+		root := common.Hash{byte(i)}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data":{"root":"%s"}}`, root)
+		}))
+		defer srv.Close()
+		clients = append(clients, beaconclient.NewClient(srv.URL, config.HTTPClientConfig{RecordDir: dir}))
+	}
+	for _, c := range clients {
+		_, err := c.GetBlockRoot(ctx, "head")
+		require.NoError(t, err)
+	}
+
+	// identical requests to different endpoints are recorded as a single sequence
+	replaying := beaconclient.NewReplayClient(dir)
+	for _, expected := range []common.Hash{{1}, {2}} {
+		root, err := replaying.GetBlockRoot(ctx, "head")
+		require.NoError(t, err)
+		assert.Equal(t, expected, root)
+	}
+}
+
+func TestRecordReplayRPC(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "eth_chainId":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x5"}`, req.ID)
+		case "eth_blockNumber":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x64"}`, req.ID)
+		}
+	}))
+
+	raw, err := fixtures.DialRPC(ctx, config.HTTPClientConfig{URL: srv.URL, RecordDir: dir})
+	require.NoError(t, err)
+	client := ethclient.NewClient(raw)
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, chainID.Int64())
+	number, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, number)
+	srv.Close()
+
+	raw, err = fixtures.DialRPC(ctx, config.HTTPClientConfig{ReplayDir: dir})
+	require.NoError(t, err)
+	client = ethclient.NewClient(raw)
+	// request ids of the new client differ from the recorded ones
+	number, err = client.BlockNumber(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, number)
+	chainID, err = client.ChainID(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, chainID.Int64())
+}
@@ -0,0 +1,89 @@
+package forks
+
+type ExecutionPayloadBellatrix struct {
+	ParentHash    []byte `ssz-size:"32"`
+	FeeRecipient  []byte `ssz-size:"20"`
+	StateRoot     []byte `ssz-size:"32"`
+	ReceiptsRoot  []byte `ssz-size:"32"`
+	LogsBloom     []byte `ssz-size:"256"`
+	PrevRandao    []byte `ssz-size:"32"`
+	BlockNumber   uint64
+	GasLimit      uint64
+	GasUsed       uint64
+	Timestamp     uint64
+	ExtraData     []byte   `ssz-max:"32"`
+	BaseFeePerGas []byte   `ssz-size:"32"`
+	BlockHash     []byte   `ssz-size:"32"`
+	Transactions  [][]byte `ssz-size:"?,?" ssz-max:"1048576,1073741824"`
+}
+
+type ExecutionPayloadHeaderBellatrix struct {
+	ParentHash       []byte `ssz-size:"32"`
+	FeeRecipient     []byte `ssz-size:"20"`
+	StateRoot        []byte `ssz-size:"32"`
+	ReceiptsRoot     []byte `ssz-size:"32"`
+	LogsBloom        []byte `ssz-size:"256"`
+	PrevRandao       []byte `ssz-size:"32"`
+	BlockNumber      uint64
+	GasLimit         uint64
+	GasUsed          uint64
+	Timestamp        uint64
+	ExtraData        []byte `ssz-max:"32"`
+	BaseFeePerGas    []byte `ssz-size:"32"`
+	BlockHash        []byte `ssz-size:"32"`
+	TransactionsRoot []byte `ssz-size:"32"`
+}
+
+type BeaconBlockBodyBellatrix struct {
+	RandaoReveal      []byte `ssz-size:"96"`
+	Eth1Data          *Eth1Data
+	Graffiti          []byte                 `ssz-size:"32"`
+	ProposerSlashings []*ProposerSlashing    `ssz-max:"16"`
+	AttesterSlashings []*AttesterSlashing    `ssz-max:"2"`
+	Attestations      []*Attestation         `ssz-max:"128"`
+	Deposits          []*Deposit             `ssz-max:"16"`
+	VoluntaryExits    []*SignedVoluntaryExit `ssz-max:"16"`
+	SyncAggregate     *SyncAggregate
+	ExecutionPayload  *ExecutionPayloadBellatrix
+}
+
+type BeaconBlockBellatrix struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    []byte `ssz-size:"32"`
+	StateRoot     []byte `ssz-size:"32"`
+	Body          *BeaconBlockBodyBellatrix
+}
+
+type SignedBeaconBlockBellatrix struct {
+	Message   *BeaconBlockBellatrix
+	Signature []byte `ssz-size:"96"`
+}
+
+type BeaconStateBellatrix struct {
+	GenesisTime                  uint64
+	GenesisValidatorsRoot        []byte `ssz-size:"32"`
+	Slot                         uint64
+	Fork                         *Fork
+	LatestBlockHeader            *BeaconBlockHeader
+	BlockRoots                   [][]byte `ssz-size:"8192,32"`
+	StateRoots                   [][]byte `ssz-size:"8192,32"`
+	HistoricalRoots              [][]byte `ssz-size:"?,32" ssz-max:"16777216"`
+	Eth1Data                     *Eth1Data
+	Eth1DataVotes                []*Eth1Data `ssz-max:"2048"`
+	Eth1DepositIndex             uint64
+	Validators                   []*Validator `ssz-max:"1099511627776"`
+	Balances                     []uint64     `ssz-max:"1099511627776"`
+	RandaoMixes                  [][]byte     `ssz-size:"65536,32"`
+	Slashings                    []uint64     `ssz-size:"8192"`
+	PreviousEpochParticipation   []byte       `ssz-max:"1099511627776"`
+	CurrentEpochParticipation    []byte       `ssz-max:"1099511627776"`
+	JustificationBits            []byte       `ssz-size:"1"`
+	PreviousJustifiedCheckpoint  *Checkpoint
+	CurrentJustifiedCheckpoint   *Checkpoint
+	FinalizedCheckpoint          *Checkpoint
+	InactivityScores             []uint64 `ssz-max:"1099511627776"`
+	CurrentSyncCommittee         *SyncCommittee
+	NextSyncCommittee            *SyncCommittee
+	LatestExecutionPayloadHeader *ExecutionPayloadHeaderBellatrix
+}
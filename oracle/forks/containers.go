@@ -0,0 +1,131 @@
+package forks
+
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path . --output ./generated.ssz.go
+
+// Containers that are shared between several forks, sizes correspond to the mainnet preset.
+
+type Fork struct {
+	PreviousVersion []byte `ssz-size:"4"`
+	CurrentVersion  []byte `ssz-size:"4"`
+	Epoch           uint64
+}
+
+type Checkpoint struct {
+	Epoch uint64
+	Root  []byte `ssz-size:"32"`
+}
+
+type BeaconBlockHeader struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    []byte `ssz-size:"32"`
+	StateRoot     []byte `ssz-size:"32"`
+	BodyRoot      []byte `ssz-size:"32"`
+}
+
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader
+	Signature []byte `ssz-size:"96"`
+}
+
+type Eth1Data struct {
+	DepositRoot  []byte `ssz-size:"32"`
+	DepositCount uint64
+	BlockHash    []byte `ssz-size:"32"`
+}
+
+type Validator struct {
+	Pubkey                     []byte `ssz-size:"48"`
+	WithdrawalCredentials      []byte `ssz-size:"32"`
+	EffectiveBalance           uint64
+	Slashed                    bool
+	ActivationEligibilityEpoch uint64
+	ActivationEpoch            uint64
+	ExitEpoch                  uint64
+	WithdrawableEpoch          uint64
+}
+
+type AttestationData struct {
+	Slot            uint64
+	Index           uint64
+	BeaconBlockRoot []byte `ssz-size:"32"`
+	Source          *Checkpoint
+	Target          *Checkpoint
+}
+
+type Attestation struct {
+	AggregationBits []byte `ssz:"bitlist" ssz-max:"2048"`
+	Data            *AttestationData
+	Signature       []byte `ssz-size:"96"`
+}
+
+type IndexedAttestation struct {
+	AttestingIndices []uint64 `ssz-max:"2048"`
+	Data             *AttestationData
+	Signature        []byte `ssz-size:"96"`
+}
+
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation
+	Attestation2 *IndexedAttestation
+}
+
+type ProposerSlashing struct {
+	Header1 *SignedBeaconBlockHeader
+	Header2 *SignedBeaconBlockHeader
+}
+
+type DepositData struct {
+	Pubkey                []byte `ssz-size:"48"`
+	WithdrawalCredentials []byte `ssz-size:"32"`
+	Amount                uint64
+	Signature             []byte `ssz-size:"96"`
+}
+
+type Deposit struct {
+	Proof [][]byte `ssz-size:"33,32"`
+	Data  *DepositData
+}
+
+type VoluntaryExit struct {
+	Epoch          uint64
+	ValidatorIndex uint64
+}
+
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit
+	Signature []byte `ssz-size:"96"`
+}
+
+type SyncAggregate struct {
+	SyncCommitteeBits      []byte `ssz-size:"64"`
+	SyncCommitteeSignature []byte `ssz-size:"96"`
+}
+
+type SyncCommittee struct {
+	Pubkeys         [][]byte `ssz-size:"512,48"`
+	AggregatePubkey []byte   `ssz-size:"48"`
+}
+
+type Withdrawal struct {
+	Index          uint64
+	ValidatorIndex uint64
+	Address        []byte `ssz-size:"20"`
+	Amount         uint64
+}
+
+type BLSToExecutionChange struct {
+	ValidatorIndex     uint64
+	FromBLSPubkey      []byte `ssz-size:"48"`
+	ToExecutionAddress []byte `ssz-size:"20"`
+}
+
+type SignedBLSToExecutionChange struct {
+	Message   *BLSToExecutionChange
+	Signature []byte `ssz-size:"96"`
+}
+
+type HistoricalSummary struct {
+	BlockSummaryRoot []byte `ssz-size:"32"`
+	StateSummaryRoot []byte `ssz-size:"32"`
+}
@@ -0,0 +1,133 @@
+package forks
+
+type AttestationElectra struct {
+	AggregationBits []byte `ssz:"bitlist" ssz-max:"131072"`
+	Data            *AttestationData
+	Signature       []byte `ssz-size:"96"`
+	CommitteeBits   []byte `ssz-size:"8"`
+}
+
+type IndexedAttestationElectra struct {
+	AttestingIndices []uint64 `ssz-max:"131072"`
+	Data             *AttestationData
+	Signature        []byte `ssz-size:"96"`
+}
+
+type AttesterSlashingElectra struct {
+	Attestation1 *IndexedAttestationElectra
+	Attestation2 *IndexedAttestationElectra
+}
+
+type DepositRequest struct {
+	Pubkey                []byte `ssz-size:"48"`
+	WithdrawalCredentials []byte `ssz-size:"32"`
+	Amount                uint64
+	Signature             []byte `ssz-size:"96"`
+	Index                 uint64
+}
+
+type WithdrawalRequest struct {
+	SourceAddress   []byte `ssz-size:"20"`
+	ValidatorPubkey []byte `ssz-size:"48"`
+	Amount          uint64
+}
+
+type ConsolidationRequest struct {
+	SourceAddress []byte `ssz-size:"20"`
+	SourcePubkey  []byte `ssz-size:"48"`
+	TargetPubkey  []byte `ssz-size:"48"`
+}
+
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `ssz-max:"8192"`
+	Withdrawals    []*WithdrawalRequest    `ssz-max:"16"`
+	Consolidations []*ConsolidationRequest `ssz-max:"2"`
+}
+
+type PendingDeposit struct {
+	Pubkey                []byte `ssz-size:"48"`
+	WithdrawalCredentials []byte `ssz-size:"32"`
+	Amount                uint64
+	Signature             []byte `ssz-size:"96"`
+	Slot                  uint64
+}
+
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    uint64
+	Amount            uint64
+	WithdrawableEpoch uint64
+}
+
+type PendingConsolidation struct {
+	SourceIndex uint64
+	TargetIndex uint64
+}
+
+type BeaconBlockBodyElectra struct {
+	RandaoReveal          []byte `ssz-size:"96"`
+	Eth1Data              *Eth1Data
+	Graffiti              []byte                     `ssz-size:"32"`
+	ProposerSlashings     []*ProposerSlashing        `ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashingElectra `ssz-max:"1"`
+	Attestations          []*AttestationElectra      `ssz-max:"8"`
+	Deposits              []*Deposit                 `ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit     `ssz-max:"16"`
+	SyncAggregate         *SyncAggregate
+	ExecutionPayload      *ExecutionPayloadDeneb
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `ssz-max:"16"`
+	BlobKZGCommitments    [][]byte                      `ssz-size:"?,48" ssz-max:"4096"`
+	ExecutionRequests     *ExecutionRequests
+}
+
+type BeaconBlockElectra struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    []byte `ssz-size:"32"`
+	StateRoot     []byte `ssz-size:"32"`
+	Body          *BeaconBlockBodyElectra
+}
+
+type SignedBeaconBlockElectra struct {
+	Message   *BeaconBlockElectra
+	Signature []byte `ssz-size:"96"`
+}
+
+type BeaconStateElectra struct {
+	GenesisTime                   uint64
+	GenesisValidatorsRoot         []byte `ssz-size:"32"`
+	Slot                          uint64
+	Fork                          *Fork
+	LatestBlockHeader             *BeaconBlockHeader
+	BlockRoots                    [][]byte `ssz-size:"8192,32"`
+	StateRoots                    [][]byte `ssz-size:"8192,32"`
+	HistoricalRoots               [][]byte `ssz-size:"?,32" ssz-max:"16777216"`
+	Eth1Data                      *Eth1Data
+	Eth1DataVotes                 []*Eth1Data `ssz-max:"2048"`
+	Eth1DepositIndex              uint64
+	Validators                    []*Validator `ssz-max:"1099511627776"`
+	Balances                      []uint64     `ssz-max:"1099511627776"`
+	RandaoMixes                   [][]byte     `ssz-size:"65536,32"`
+	Slashings                     []uint64     `ssz-size:"8192"`
+	PreviousEpochParticipation    []byte       `ssz-max:"1099511627776"`
+	CurrentEpochParticipation     []byte       `ssz-max:"1099511627776"`
+	JustificationBits             []byte       `ssz-size:"1"`
+	PreviousJustifiedCheckpoint   *Checkpoint
+	CurrentJustifiedCheckpoint    *Checkpoint
+	FinalizedCheckpoint           *Checkpoint
+	InactivityScores              []uint64 `ssz-max:"1099511627776"`
+	CurrentSyncCommittee          *SyncCommittee
+	NextSyncCommittee             *SyncCommittee
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeaderDeneb
+	NextWithdrawalIndex           uint64
+	NextWithdrawalValidatorIndex  uint64
+	HistoricalSummaries           []*HistoricalSummary `ssz-max:"16777216"`
+	DepositRequestsStartIndex     uint64
+	DepositBalanceToConsume       uint64
+	ExitBalanceToConsume          uint64
+	EarliestExitEpoch             uint64
+	ConsolidationBalanceToConsume uint64
+	EarliestConsolidationEpoch    uint64
+	PendingDeposits               []*PendingDeposit           `ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal `ssz-max:"134217728"`
+	PendingConsolidations         []*PendingConsolidation     `ssz-max:"262144"`
+}
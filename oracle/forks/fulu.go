@@ -0,0 +1,44 @@
+package forks
+
+// Fulu doesn't change the beacon block structure, so Electra block types are used for it.
+
+type BeaconStateFulu struct {
+	GenesisTime                   uint64
+	GenesisValidatorsRoot         []byte `ssz-size:"32"`
+	Slot                          uint64
+	Fork                          *Fork
+	LatestBlockHeader             *BeaconBlockHeader
+	BlockRoots                    [][]byte `ssz-size:"8192,32"`
+	StateRoots                    [][]byte `ssz-size:"8192,32"`
+	HistoricalRoots               [][]byte `ssz-size:"?,32" ssz-max:"16777216"`
+	Eth1Data                      *Eth1Data
+	Eth1DataVotes                 []*Eth1Data `ssz-max:"2048"`
+	Eth1DepositIndex              uint64
+	Validators                    []*Validator `ssz-max:"1099511627776"`
+	Balances                      []uint64     `ssz-max:"1099511627776"`
+	RandaoMixes                   [][]byte     `ssz-size:"65536,32"`
+	Slashings                     []uint64     `ssz-size:"8192"`
+	PreviousEpochParticipation    []byte       `ssz-max:"1099511627776"`
+	CurrentEpochParticipation     []byte       `ssz-max:"1099511627776"`
+	JustificationBits             []byte       `ssz-size:"1"`
+	PreviousJustifiedCheckpoint   *Checkpoint
+	CurrentJustifiedCheckpoint    *Checkpoint
+	FinalizedCheckpoint           *Checkpoint
+	InactivityScores              []uint64 `ssz-max:"1099511627776"`
+	CurrentSyncCommittee          *SyncCommittee
+	NextSyncCommittee             *SyncCommittee
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeaderDeneb
+	NextWithdrawalIndex           uint64
+	NextWithdrawalValidatorIndex  uint64
+	HistoricalSummaries           []*HistoricalSummary `ssz-max:"16777216"`
+	DepositRequestsStartIndex     uint64
+	DepositBalanceToConsume       uint64
+	ExitBalanceToConsume          uint64
+	EarliestExitEpoch             uint64
+	ConsolidationBalanceToConsume uint64
+	EarliestConsolidationEpoch    uint64
+	PendingDeposits               []*PendingDeposit           `ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal `ssz-max:"134217728"`
+	PendingConsolidations         []*PendingConsolidation     `ssz-max:"262144"`
+	ProposerLookahead             []uint64                    `ssz-size:"64"`
+}
@@ -0,0 +1,219 @@
+package forks
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+type Version int
+
+const (
+	Phase0 Version = iota
+	Altair
+	Bellatrix
+	Capella
+	Deneb
+	Electra
+	Fulu
+)
+
+var versionNames = []string{"phase0", "altair", "bellatrix", "capella", "deneb", "electra", "fulu"}
+
+func (v Version) String() string {
+	if v < 0 || int(v) >= len(versionNames) {
+		return fmt.Sprintf("unknown(%d)", int(v))
+	}
+	return versionNames[v]
+}
+
+// ParseVersion parses fork name, as returned in the Eth-Consensus-Version header
+func ParseVersion(s string) (Version, error) {
+	for i, name := range versionNames {
+		if strings.EqualFold(name, s) {
+			return Version(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown fork version %q", s)
+}
+
+type Object interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// BeaconBlock is a fork independent view of the beacon block.
+// Execution payload of earlier forks is upcasted to the latest layout, with missing fields left empty.
+type BeaconBlock struct {
+	Version          Version
+	Slot             uint64
+	ProposerIndex    uint64
+	ParentRoot       common.Hash
+	StateRoot        common.Hash
+	BodyRoot         common.Hash
+	SyncAggregate    *SyncAggregate
+	ExecutionPayload *ExecutionPayloadDeneb
+	// Signed holds the original fork specific signed beacon block
+	Signed Object
+}
+
+// BeaconState is a fork independent view of the beacon state.
+// It contains a superset of the fields from all supported forks, fields missing in the given fork are left empty.
+type BeaconState struct {
+	Version                       Version
+	GenesisTime                   uint64
+	GenesisValidatorsRoot         []byte
+	Slot                          uint64
+	Fork                          *Fork
+	LatestBlockHeader             *BeaconBlockHeader
+	BlockRoots                    [][]byte
+	StateRoots                    [][]byte
+	HistoricalRoots               [][]byte
+	Eth1Data                      *Eth1Data
+	Eth1DataVotes                 []*Eth1Data
+	Eth1DepositIndex              uint64
+	Validators                    []*Validator
+	Balances                      []uint64
+	RandaoMixes                   [][]byte
+	Slashings                     []uint64
+	PreviousEpochParticipation    []byte
+	CurrentEpochParticipation     []byte
+	JustificationBits             []byte
+	PreviousJustifiedCheckpoint   *Checkpoint
+	CurrentJustifiedCheckpoint    *Checkpoint
+	FinalizedCheckpoint           *Checkpoint
+	InactivityScores              []uint64
+	CurrentSyncCommittee          *SyncCommittee
+	NextSyncCommittee             *SyncCommittee
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeaderDeneb
+	NextWithdrawalIndex           uint64
+	NextWithdrawalValidatorIndex  uint64
+	HistoricalSummaries           []*HistoricalSummary
+	DepositRequestsStartIndex     uint64
+	DepositBalanceToConsume       uint64
+	ExitBalanceToConsume          uint64
+	EarliestExitEpoch             uint64
+	ConsolidationBalanceToConsume uint64
+	EarliestConsolidationEpoch    uint64
+	PendingDeposits               []*PendingDeposit
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal
+	PendingConsolidations         []*PendingConsolidation
+	ProposerLookahead             []uint64
+	// Raw holds the original fork specific beacon state
+	Raw Object
+}
+
+func DecodeSignedBeaconBlock(version Version, data []byte) (*BeaconBlock, error) {
+	var signed Object
+	switch version {
+	case Bellatrix:
+		signed = new(SignedBeaconBlockBellatrix)
+	case Capella:
+		signed = new(SignedBeaconBlockCapella)
+	case Deneb:
+		signed = new(SignedBeaconBlockDeneb)
+	case Electra, Fulu:
+		signed = new(SignedBeaconBlockElectra)
+	default:
+		return nil, fmt.Errorf("unsupported beacon block fork version %s", version)
+	}
+	if err := signed.UnmarshalSSZ(data); err != nil {
+		return nil, fmt.Errorf("can't unmarshal %s beacon block: %w", version, err)
+	}
+	return NewBeaconBlock(version, signed)
+}
+
+// NewBeaconBlock makes a fork independent view of the given fork specific signed beacon block
+func NewBeaconBlock(version Version, signed Object) (*BeaconBlock, error) {
+	// signed block types differ only in the message type, which shares the common header fields
+	message := reflect.ValueOf(signed).Elem().FieldByName("Message")
+	if !message.IsValid() || message.IsNil() {
+		return nil, fmt.Errorf("invalid signed beacon block %T", signed)
+	}
+	body := message.Elem().FieldByName("Body").Interface().(ssz.HashRoot)
+	bodyRoot, err := body.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("can't calculate block body root: %w", err)
+	}
+	block := &BeaconBlock{
+		Version:          version,
+		BodyRoot:         bodyRoot,
+		ExecutionPayload: new(ExecutionPayloadDeneb),
+		Signed:           signed,
+	}
+	upcast(block, message.Interface())
+	block.ParentRoot = common.BytesToHash(message.Elem().FieldByName("ParentRoot").Bytes())
+	block.StateRoot = common.BytesToHash(message.Elem().FieldByName("StateRoot").Bytes())
+	upcast(block, body)
+	upcast(block.ExecutionPayload, reflect.ValueOf(body).Elem().FieldByName("ExecutionPayload").Interface())
+	return block, nil
+}
+
+// Root returns the beacon block root, which is equal to the hash tree root of its header
+func (b *BeaconBlock) Root() common.Hash {
+	header := &BeaconBlockHeader{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot.Bytes(),
+		StateRoot:     b.StateRoot.Bytes(),
+		BodyRoot:      b.BodyRoot.Bytes(),
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		panic(err)
+	}
+	return root
+}
+
+func DecodeBeaconState(version Version, data []byte) (*BeaconState, error) {
+	var raw Object
+	switch version {
+	case Bellatrix:
+		raw = new(BeaconStateBellatrix)
+	case Capella:
+		raw = new(BeaconStateCapella)
+	case Deneb:
+		raw = new(BeaconStateDeneb)
+	case Electra:
+		raw = new(BeaconStateElectra)
+	case Fulu:
+		raw = new(BeaconStateFulu)
+	default:
+		return nil, fmt.Errorf("unsupported beacon state fork version %s", version)
+	}
+	if err := raw.UnmarshalSSZ(data); err != nil {
+		return nil, fmt.Errorf("can't unmarshal %s beacon state: %w", version, err)
+	}
+	return NewBeaconState(version, raw), nil
+}
+
+// NewBeaconState makes a fork independent view of the given fork specific beacon state
+func NewBeaconState(version Version, raw Object) *BeaconState {
+	state := &BeaconState{
+		Version:                      version,
+		LatestExecutionPayloadHeader: new(ExecutionPayloadHeaderDeneb),
+		Raw:                          raw,
+	}
+	upcast(state, raw)
+	upcast(state.LatestExecutionPayloadHeader, reflect.ValueOf(raw).Elem().FieldByName("LatestExecutionPayloadHeader").Interface())
+	return state
+}
+
+// upcast copies all fields from src struct pointer into the fields with the same name and type of dst struct pointer.
+func upcast(dst interface{}, src interface{}) {
+	if reflect.ValueOf(src).IsNil() {
+		return
+	}
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for i := 0; i < s.NumField(); i++ {
+		f := d.FieldByName(s.Type().Field(i).Name)
+		if f.IsValid() && s.Field(i).Type().AssignableTo(f.Type()) {
+			f.Set(s.Field(i))
+		}
+	}
+}
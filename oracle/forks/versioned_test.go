@@ -0,0 +1,79 @@
+package forks
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	v, err := ParseVersion("deneb")
+	require.NoError(t, err)
+	assert.Equal(t, Deneb, v)
+
+	v, err = ParseVersion("ELECTRA")
+	require.NoError(t, err)
+	assert.Equal(t, Electra, v)
+
+	_, err = ParseVersion("unknown")
+	assert.Error(t, err)
+}
+
+func TestDecodeSignedBeaconBlock(t *testing.T) {
+	signed := &SignedBeaconBlockCapella{
+		Message: &BeaconBlockCapella{
+			Slot:          100,
+			ProposerIndex: 5,
+			ParentRoot:    common.Hash{1}.Bytes(),
+			StateRoot:     common.Hash{2}.Bytes(),
+			Body: &BeaconBlockBodyCapella{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data:     &Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+				Graffiti:     make([]byte, 32),
+				SyncAggregate: &SyncAggregate{
+					SyncCommitteeBits:      append([]byte{0xff}, make([]byte, 63)...),
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+				ExecutionPayload: &ExecutionPayloadCapella{
+					ParentHash:    make([]byte, 32),
+					FeeRecipient:  make([]byte, 20),
+					StateRoot:     make([]byte, 32),
+					ReceiptsRoot:  common.Hash{3}.Bytes(),
+					LogsBloom:     make([]byte, 256),
+					PrevRandao:    make([]byte, 32),
+					BlockNumber:   1234,
+					BaseFeePerGas: make([]byte, 32),
+					BlockHash:     make([]byte, 32),
+					Withdrawals:   []*Withdrawal{{Index: 1, Address: make([]byte, 20), Amount: 2}},
+				},
+			},
+		},
+		Signature: make([]byte, 96),
+	}
+	data, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+
+	block, err := DecodeSignedBeaconBlock(Capella, data)
+	require.NoError(t, err)
+
+	bodyRoot, err := signed.Message.Body.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, Capella, block.Version)
+	assert.Equal(t, uint64(100), block.Slot)
+	assert.Equal(t, uint64(5), block.ProposerIndex)
+	assert.Equal(t, common.Hash{1}, block.ParentRoot)
+	assert.Equal(t, common.Hash{2}, block.StateRoot)
+	assert.Equal(t, common.Hash(bodyRoot), block.BodyRoot)
+	assert.Equal(t, signed.Message.Body.SyncAggregate, block.SyncAggregate)
+	assert.Equal(t, uint64(1234), block.ExecutionPayload.BlockNumber)
+	assert.Equal(t, common.Hash{3}.Bytes(), block.ExecutionPayload.ReceiptsRoot)
+	assert.Len(t, block.ExecutionPayload.Withdrawals, 1)
+	assert.Zero(t, block.ExecutionPayload.BlobGasUsed)
+
+	_, err = DecodeSignedBeaconBlock(Deneb, data)
+	assert.Error(t, err)
+	_, err = DecodeSignedBeaconBlock(Altair, data)
+	assert.Error(t, err)
+}
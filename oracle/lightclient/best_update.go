@@ -0,0 +1,221 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/go-bitfield"
+
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+// UpdateCandidate holds the signature block together with the properties compared by is_better_update
+type UpdateCandidate struct {
+	SignatureSlot uint64
+	AttestedSlot  uint64
+	Participants  uint64
+	// Finality is false for updates without finality proof, FinalizedSlot is zero then
+	Finality      bool
+	FinalizedSlot uint64
+
+	signatureBlock *forks.BeaconBlock
+}
+
+func (c *LightClient) period(slot uint64) uint64 {
+	return slot / (c.Spec.SlotsPerEpoch * c.Spec.EpochsPerSyncCommitteePeriod)
+}
+
+func (c *LightClient) hasSupermajority(u *UpdateCandidate) bool {
+	return 3*u.Participants >= 2*uint64(c.Spec.SyncCommitteeSize)
+}
+
+// hasRelevantSyncCommittee mirrors the spec check for the next sync committee, that is signed by the same period.
+// Updates made here always prove the signing committee against the light client head, so only the periods are compared.
+func (c *LightClient) hasRelevantSyncCommittee(u *UpdateCandidate) bool {
+	return c.period(u.AttestedSlot) == c.period(u.SignatureSlot)
+}
+
+func (c *LightClient) hasSyncCommitteeFinality(u *UpdateCandidate) bool {
+	return c.period(u.FinalizedSlot) == c.period(u.AttestedSlot)
+}
+
+// IsBetterUpdate reports whether newUpdate is better than oldUpdate by is_better_update from the Altair light client sync protocol:
+// supermajority of signatures, relevant sync committee, finality, sync committee finality, participation, and then older data
+func (c *LightClient) IsBetterUpdate(newUpdate, oldUpdate *UpdateCandidate) bool {
+	newSupermajority, oldSupermajority := c.hasSupermajority(newUpdate), c.hasSupermajority(oldUpdate)
+	if newSupermajority != oldSupermajority {
+		return newSupermajority
+	}
+	if !newSupermajority && newUpdate.Participants != oldUpdate.Participants {
+		return newUpdate.Participants > oldUpdate.Participants
+	}
+
+	newRelevant, oldRelevant := c.hasRelevantSyncCommittee(newUpdate), c.hasRelevantSyncCommittee(oldUpdate)
+	if newRelevant != oldRelevant {
+		return newRelevant
+	}
+
+	if newUpdate.Finality != oldUpdate.Finality {
+		return newUpdate.Finality
+	}
+	if newUpdate.Finality {
+		newSyncCommitteeFinality, oldSyncCommitteeFinality := c.hasSyncCommitteeFinality(newUpdate), c.hasSyncCommitteeFinality(oldUpdate)
+		if newSyncCommitteeFinality != oldSyncCommitteeFinality {
+			return newSyncCommitteeFinality
+		}
+	}
+
+	if newUpdate.Participants != oldUpdate.Participants {
+		return newUpdate.Participants > oldUpdate.Participants
+	}
+	if newUpdate.AttestedSlot != oldUpdate.AttestedSlot {
+		return newUpdate.AttestedSlot < oldUpdate.AttestedSlot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+// describeCandidate formats all is_better_update criteria of the candidate for logs
+func (c *LightClient) describeCandidate(u *UpdateCandidate) string {
+	res := fmt.Sprintf("signature slot %d, attested slot %d, %d participants, supermajority: %t, relevant sync committee: %t",
+		u.SignatureSlot, u.AttestedSlot, u.Participants, c.hasSupermajority(u), c.hasRelevantSyncCommittee(u))
+	if u.Finality {
+		res += fmt.Sprintf(", finalized slot %d, sync committee finality: %t", u.FinalizedSlot, c.hasSyncCommitteeFinality(u))
+	}
+	return res
+}
+
+// makeUpdateCandidate collects the compared properties of the update, signed in the given block.
+// In finality mode the finalized block is found by the finality checkpoints of the attested state,
+// the full state is only downloaded for the chosen candidate.
+func (c *LightClient) makeUpdateCandidate(ctx context.Context, signatureBlock *forks.BeaconBlock) (*UpdateCandidate, error) {
+	attestedBlock, err := c.Client.GetBlock(ctx, signatureBlock.ParentRoot.String())
+	if err != nil {
+		return nil, fmt.Errorf("can't get block %s: %w", signatureBlock.ParentRoot, err)
+	}
+	res := &UpdateCandidate{
+		SignatureSlot:  signatureBlock.Slot,
+		AttestedSlot:   attestedBlock.Slot,
+		Participants:   bitfield.Bitvector512(signatureBlock.SyncAggregate.SyncCommitteeBits).Count(),
+		signatureBlock: signatureBlock,
+	}
+	if !c.WithFinality {
+		return res, nil
+	}
+	checkpoints, err := c.Client.GetFinalityCheckpoints(ctx, attestedBlock.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("can't get finality checkpoints of state %d: %w", attestedBlock.Slot, err)
+	}
+	if checkpoints.Finalized.Root == (common.Hash{}) {
+		return res, nil
+	}
+	finalizedBlock, err := c.Client.GetBlock(ctx, checkpoints.Finalized.Root.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("can't get finalized block: %w", err)
+	}
+	res.Finality = true
+	res.FinalizedSlot = finalizedBlock.Slot
+	return res, nil
+}
+
+// findBestSignatureBlock scans UpdateWindow signature blocks down from the given slot and returns the one making the best update,
+// candidates that can't move the light client head from cur or have invalid signatures are skipped.
+// Without the window, the first block with enough signatures is taken.
+func (c *LightClient) findBestSignatureBlock(ctx context.Context, slot uint64, cur *lazyState) (*forks.BeaconBlock, error) {
+	curSlot := cur.slot
+	if c.UpdateWindow == 0 {
+		return c.findSignatureBlock(ctx, slot, curSlot)
+	}
+	var candidates []*UpdateCandidate
+	for i := uint64(0); i < c.UpdateWindow; i++ {
+		block, err := c.findSignatureBlock(ctx, slot, curSlot)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			break
+		}
+		slot = block.Slot - 1
+
+		candidate, err := c.makeUpdateCandidate(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		activeSlot := candidate.AttestedSlot
+		if c.WithFinality {
+			activeSlot = candidate.FinalizedSlot
+		}
+		if activeSlot <= curSlot {
+			log.Printf("Skipping update candidate with %s, it doesn't move the head from slot %d\n", c.describeCandidate(candidate), curSlot)
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+	candidates, err := c.verifyCandidates(ctx, cur, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *UpdateCandidate
+	for _, candidate := range candidates {
+		log.Printf("Update candidate with %s\n", c.describeCandidate(candidate))
+		if best == nil || c.IsBetterUpdate(candidate, best) {
+			best = candidate
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	log.Printf("Best update has %s\n", c.describeCandidate(best))
+	return best.signatureBlock, nil
+}
+
+// verifyCandidates batch verifies the sync aggregate signatures of the candidates with the sync committees of the head state,
+// candidates with invalid signatures are filtered out
+func (c *LightClient) verifyCandidates(ctx context.Context, cur *lazyState, candidates []*UpdateCandidate) ([]*UpdateCandidate, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	state, err := cur.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	committees := make(map[uint64]*SyncCommittee)
+	var verified []*UpdateCandidate
+	var sets []crypto.SignatureSet
+	for _, candidate := range candidates {
+		period := c.period(candidate.SignatureSlot)
+		cmt, ok := committees[period]
+		if !ok {
+			if cmt, err = c.syncCommitteeAt(state, candidate.SignatureSlot); err != nil {
+				return nil, err
+			}
+			committees[period] = cmt
+		}
+		block := candidate.signatureBlock
+		set, err := c.signatureSet(cmt, block.SyncAggregate, block.ParentRoot, block.Slot, block)
+		if err != nil {
+			log.Printf("Skipping update candidate with %s: %s\n", c.describeCandidate(candidate), err)
+			continue
+		}
+		verified = append(verified, candidate)
+		sets = append(sets, *set)
+	}
+
+	invalid := crypto.BatchVerify(sets)
+	if len(invalid) == 0 {
+		return verified, nil
+	}
+	var res []*UpdateCandidate
+	for i, candidate := range verified {
+		if len(invalid) > 0 && invalid[0] == i {
+			invalid = invalid[1:]
+			log.Printf("Skipping update candidate with %s, its sync aggregate signature is invalid\n", c.describeCandidate(candidate))
+			continue
+		}
+		res = append(res, candidate)
+	}
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/testchain"
+)
+
+func TestIsBetterUpdate(t *testing.T) {
+	// 32 slots per sync committee period
+	c := &LightClient{Spec: testchain.DefaultSpec()}
+	base := UpdateCandidate{SignatureSlot: 40, AttestedSlot: 39, Participants: 400, Finality: true, FinalizedSlot: 32}
+
+	tests := []struct {
+		name   string
+		better func(u *UpdateCandidate)
+		worse  func(u *UpdateCandidate)
+	}{
+		{"supermajority", nil, func(u *UpdateCandidate) { u.Participants = 300 }},
+		{"participants without supermajority", func(u *UpdateCandidate) { u.Participants = 301 }, func(u *UpdateCandidate) { u.Participants = 300 }},
+		{"relevant sync committee", nil, func(u *UpdateCandidate) {
+			u.Participants = 512
+			u.SignatureSlot = 32
+			u.AttestedSlot = 31
+			u.FinalizedSlot = 16
+		}},
+		{"finality", nil, func(u *UpdateCandidate) { u.Participants = 512; u.Finality = false; u.FinalizedSlot = 0 }},
+		{"sync committee finality", nil, func(u *UpdateCandidate) { u.Participants = 512; u.FinalizedSlot = 16 }},
+		{"participants", nil, func(u *UpdateCandidate) { u.Participants = 399 }},
+		{"attested slot", nil, func(u *UpdateCandidate) { u.SignatureSlot = 41; u.AttestedSlot = 40 }},
+		{"signature slot", nil, func(u *UpdateCandidate) { u.SignatureSlot = 41 }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			better, worse := base, base
+			if test.better != nil {
+				test.better(&better)
+			}
+			test.worse(&worse)
+			assert.True(t, c.IsBetterUpdate(&better, &worse))
+			assert.False(t, c.IsBetterUpdate(&worse, &better))
+		})
+	}
+	assert.False(t, c.IsBetterUpdate(&base, &base))
+}
+
+func TestMakeUpdateWithWindow(t *testing.T) {
+	ctx := context.Background()
+	participation := map[uint64]int{32: 512, 33: 350, 38: 512, 39: 500, 40: 350}
+	chain, err := testchain.NewChain(testchain.Config{
+		Participation: func(slot uint64) int {
+			if n, ok := participation[slot]; ok {
+				return n
+			}
+			return 400
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(33))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true, UpdateWindow: 2}
+
+	// block 32 has more signatures, but attests the block from the previous sync committee period
+	update, err := c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 33, update.SignatureSlot)
+	assert.EqualValues(t, 32, update.AttestedHeader.Slot)
+
+	// with one more block, 31 wins as its finalized header belongs to the attested sync committee period
+	c.UpdateWindow = 3
+	update, err = c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 31, update.SignatureSlot)
+
+	require.NoError(t, chain.AdvanceTo(40))
+	update, err = c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 38, update.SignatureSlot)
+	assert.EqualValues(t, 16, update.FinalizedHeader.Slot)
+	assert.Empty(t, update.MissedSyncCommitteeParticipants)
+
+	// candidates are compared by the finality checkpoints, only the head and the chosen attested states are downloaded
+	counting := &stateCountingClient{Chain: chain, states: make(map[uint64]int)}
+	c.Client = counting
+	update, err = c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 38, update.SignatureSlot)
+	assert.Equal(t, map[uint64]int{0: 1, 37: 1}, counting.states)
+
+	// candidates with invalid signatures are skipped, block 38 gets the signature of block 39
+	block39, err := chain.GetBlock(ctx, "39")
+	require.NoError(t, err)
+	c.Client = &corruptingClient{Chain: chain, signature: func(slot uint64) []byte {
+		if slot == 38 {
+			return block39.SyncAggregate.SyncCommitteeSignature
+		}
+		return nil
+	}}
+	update, err = c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 39, update.SignatureSlot)
+	c.Client = chain
+
+	// candidates not moving the finalized head are skipped
+	update, err = c.MakeUpdate(ctx, 16, 0)
+	require.NoError(t, err)
+	assert.Nil(t, update)
+
+	c.UpdateWindow = 0
+	update, err = c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 40, update.SignatureSlot)
+}
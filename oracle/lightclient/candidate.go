@@ -0,0 +1,73 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BestValidUpdate is the candidate update stored by BeaconLightClient.step for updates
+// without finality proof or with less than 2/3 of sync committee signatures
+type BestValidUpdate struct {
+	Slot       uint64      `abi:"slot"`
+	Signatures uint64      `abi:"signatures"`
+	Timeout    uint64      `abi:"timeout"`
+	Root       common.Hash `abi:"root"`
+	StateRoot  common.Hash `abi:"stateRoot"`
+}
+
+// NeedsCandidates reports whether the beacon chain has finalized a block after curSlot,
+// or whether it hasn't finalized anything for more than 4 epochs by the wall clock.
+// Sync aggregates are not inspected, so the first case only means that candidates are needed,
+// if the caller has already failed to make a finality update from curSlot.
+func (c *LightClient) NeedsCandidates(ctx context.Context, curSlot uint64) (bool, error) {
+	finalized, err := c.Client.GetBlock(ctx, "finalized")
+	if err != nil {
+		return false, fmt.Errorf("can't get finalized block: %w", err)
+	}
+	clockSlot := uint64(time.Since(c.Genesis.GenesisTime).Seconds()) / c.Spec.SecondsPerSlot
+	return finalized.Slot > curSlot || clockSlot > finalized.Slot+4*c.Spec.SlotsPerEpoch, nil
+}
+
+// ActiveSlot returns the slot of the header, that becomes the light client head after the update
+func (u *Update) ActiveSlot() uint64 {
+	if len(u.FinalityBranch) > 0 {
+		return u.FinalizedHeader.Slot
+	}
+	return u.AttestedHeader.Slot
+}
+
+// Participants returns the number of sync committee members that signed the update
+func (c *LightClient) Participants(update *Update) uint64 {
+	return uint64(c.Spec.SyncCommitteeSize - len(update.MissedSyncCommitteeParticipants))
+}
+
+// IsFinal reports whether the contract applies the update immediately, instead of storing it as a candidate
+func (c *LightClient) IsFinal(update *Update) bool {
+	return len(update.FinalityBranch) > 0 && 3*c.Participants(update) >= 2*uint64(c.Spec.SyncCommitteeSize)
+}
+
+// IsBetterCandidate reports whether the contract accepts the update as a new candidate,
+// candidates that are not applied yet can only be replaced by updates with strictly more signatures
+func (c *LightClient) IsBetterCandidate(update *Update, best *BestValidUpdate, head uint64) bool {
+	if update.ActiveSlot() <= head {
+		return false
+	}
+	if best.Slot > head {
+		return c.Participants(update) > best.Signatures
+	}
+	return true
+}
+
+// CanApplyCandidate reports whether applyCandidate succeeds at the given time:
+// the candidate has to be newer than the head, and both the sync committee period and UPDATE_TIMEOUT have to pass
+func (c *LightClient) CanApplyCandidate(best *BestValidUpdate, head uint64, now time.Time) bool {
+	if best.Slot <= head || best.Root == (common.Hash{}) {
+		return false
+	}
+	slotsPerPeriod := c.Spec.EpochsPerSyncCommitteePeriod * c.Spec.SlotsPerEpoch
+	clockSlot := uint64(now.Sub(c.Genesis.GenesisTime).Seconds()) / c.Spec.SecondsPerSlot
+	return clockSlot > best.Slot+slotsPerPeriod && best.Timeout < uint64(now.Unix())
+}
@@ -0,0 +1,80 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/testchain"
+)
+
+func TestCandidates(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{
+		Participation: func(slot uint64) int { return 300 },
+	})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(40))
+
+	// less than 2/3 of the sync committee never produces finality updates
+	finality := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true}
+	update, err := finality.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Nil(t, update)
+
+	optimistic := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis}
+	update, err = optimistic.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 39, update.ActiveSlot())
+	assert.EqualValues(t, 300, optimistic.Participants(update))
+	assert.False(t, optimistic.IsFinal(update))
+
+	// epoch 3 is finalized, but can't be proven with the sync committee
+	needed, err := finality.NeedsCandidates(ctx, 0)
+	require.NoError(t, err)
+	assert.True(t, needed)
+	// the chain didn't produce new blocks for a long time
+	needed, err = finality.NeedsCandidates(ctx, 24)
+	require.NoError(t, err)
+	assert.True(t, needed)
+
+	assert.True(t, optimistic.IsBetterCandidate(update, &BestValidUpdate{}, 0))
+	assert.False(t, optimistic.IsBetterCandidate(update, &BestValidUpdate{}, 39))
+	assert.True(t, optimistic.IsBetterCandidate(update, &BestValidUpdate{Slot: 20, Signatures: 299}, 0))
+	assert.False(t, optimistic.IsBetterCandidate(update, &BestValidUpdate{Slot: 20, Signatures: 300}, 0))
+	// stale candidates, that are behind the head, are always replaced
+	assert.True(t, optimistic.IsBetterCandidate(update, &BestValidUpdate{Slot: 20, Signatures: 500}, 30))
+
+	slotsPerPeriod := chain.Spec.SlotsPerEpoch * chain.Spec.EpochsPerSyncCommitteePeriod
+	slotTime := func(slot uint64) time.Time {
+		return chain.Genesis.GenesisTime.Add(time.Duration(slot*chain.Spec.SecondsPerSlot) * time.Second)
+	}
+	best := &BestValidUpdate{Slot: 39, Signatures: 300, Root: common.Hash{1}}
+	best.Timeout = uint64(slotTime(50).Unix())
+	assert.False(t, optimistic.CanApplyCandidate(best, 0, slotTime(39+slotsPerPeriod)))
+	assert.True(t, optimistic.CanApplyCandidate(best, 0, slotTime(40+slotsPerPeriod)))
+	assert.False(t, optimistic.CanApplyCandidate(best, 39, slotTime(40+slotsPerPeriod)))
+	best.Timeout = uint64(slotTime(100).Unix())
+	assert.False(t, optimistic.CanApplyCandidate(best, 0, slotTime(40+slotsPerPeriod)))
+	assert.False(t, optimistic.CanApplyCandidate(&BestValidUpdate{Slot: 39}, 0, slotTime(1000)))
+}
+
+func TestNeedsCandidates(t *testing.T) {
+	ctx := context.Background()
+	spec := testchain.DefaultSpec()
+	chain, err := testchain.NewChain(testchain.Config{
+		GenesisTime: time.Now().Add(-time.Duration(40*spec.SecondsPerSlot) * time.Second),
+	})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(40))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true}
+
+	needed, err := c.NeedsCandidates(ctx, 24)
+	require.NoError(t, err)
+	assert.False(t, needed)
+}
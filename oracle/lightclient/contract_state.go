@@ -0,0 +1,50 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"oracle/contract"
+)
+
+// ReadContractState reads the BeaconLightClient state at the latest block
+func ReadContractState(ctx context.Context, client *ethclient.Client, address common.Address) (*ContractState, error) {
+	latest, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("can't get latest block: %w", err)
+	}
+	state := &ContractState{Timestamp: latest.Time}
+
+	call := func(result interface{}, method string, args ...interface{}) error {
+		data, err := contract.BeaconLightClientABI.Pack(method, args...)
+		if err != nil {
+			return err
+		}
+		res, err := client.CallContract(ctx, ethereum.CallMsg{To: &address, Data: data}, latest.Number)
+		if err != nil {
+			return fmt.Errorf("can't call %s: %w", method, err)
+		}
+		if err = contract.BeaconLightClientABI.UnpackIntoInterface(result, method, res); err != nil {
+			return fmt.Errorf("can't unpack %s: %w", method, err)
+		}
+		return nil
+	}
+
+	head := new(big.Int)
+	if err = call(&head, "head"); err != nil {
+		return nil, err
+	}
+	state.Head = head.Uint64()
+	if err = call(&state.HeadStateRoot, "stateRoot", head); err != nil {
+		return nil, err
+	}
+	if err = call(&state.BestValidUpdate, "bestValidUpdate"); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
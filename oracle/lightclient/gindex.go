@@ -0,0 +1,136 @@
+package lightclient
+
+import (
+	"fmt"
+	"math/bits"
+
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+// GenIndices holds generalized indices of the BeaconState fields used in proofs.
+// Payload* indices are relative to the latest_execution_payload_header root.
+type GenIndices struct {
+	Version                      forks.Version
+	StateRoots                   int
+	HistoricalRoots              int
+	FinalizedCheckpoint          int
+	CurrentSyncCommittee         int
+	NextSyncCommittee            int
+	LatestExecutionPayloadHeader int
+	// HistoricalSummaries is 0 before Capella
+	HistoricalSummaries int
+	PayloadStateRoot    int
+	PayloadReceiptsRoot int
+}
+
+// FinalizedRoot returns get_generalized_index(BeaconState, 'finalized_checkpoint', 'root')
+func (g *GenIndices) FinalizedRoot() int {
+	return g.FinalizedCheckpoint*2 + 1
+}
+
+// makeGenIndices builds generalized indices for the given number of BeaconState and ExecutionPayloadHeader fields.
+// Positions of the used fields are the same in all forks, only the tree depths change.
+func makeGenIndices(version forks.Version, stateFields int, payloadFields int) *GenIndices {
+	state := crypto.CeilPow2(stateFields)
+	payload := crypto.CeilPow2(payloadFields)
+	res := &GenIndices{
+		Version:                      version,
+		StateRoots:                   state + 6,
+		HistoricalRoots:              state + 7,
+		FinalizedCheckpoint:          state + 20,
+		CurrentSyncCommittee:         state + 22,
+		NextSyncCommittee:            state + 23,
+		LatestExecutionPayloadHeader: state + 24,
+		PayloadStateRoot:             payload + 2,
+		PayloadReceiptsRoot:          payload + 3,
+	}
+	if version >= forks.Capella {
+		res.HistoricalSummaries = state + 27
+	}
+	return res
+}
+
+var forkGenIndices = map[forks.Version]*GenIndices{
+	forks.Bellatrix: makeGenIndices(forks.Bellatrix, 25, 14),
+	forks.Capella:   makeGenIndices(forks.Capella, 28, 15),
+	forks.Deneb:     makeGenIndices(forks.Deneb, 28, 17),
+	forks.Electra:   makeGenIndices(forks.Electra, 37, 17),
+	forks.Fulu:      makeGenIndices(forks.Fulu, 38, 17),
+}
+
+// ContractGenIndices are the generalized indices hard-coded in BeaconLightClientConfig.sol and TrustlessAMB.sol.
+// Proofs built for any other layout would be rejected on-chain.
+var ContractGenIndices = &GenIndices{
+	Version:                      forks.Bellatrix,
+	StateRoots:                   32 + 6,
+	HistoricalRoots:              32 + 7,
+	FinalizedCheckpoint:          52, // FINALIZED_ROOT_INDEX = 105
+	CurrentSyncCommittee:         54,
+	NextSyncCommittee:            55,
+	LatestExecutionPayloadHeader: 32 + 24,
+	PayloadStateRoot:             16 + 2,
+	PayloadReceiptsRoot:          16 + 3,
+}
+
+// forkAtSlot returns the latest fork scheduled at the epoch of the given slot.
+// Forks with empty version are treated as not scheduled.
+func (c *LightClient) forkAtSlot(slot uint64) forks.Version {
+	epoch := slot / c.Spec.SlotsPerEpoch
+	schedule := []struct {
+		version     forks.Version
+		epoch       uint64
+		forkVersion string
+	}{
+		{forks.Fulu, c.Spec.FuluForkEpoch, c.Spec.FuluForkVersion},
+		{forks.Electra, c.Spec.ElectraForkEpoch, c.Spec.ElectraForkVersion},
+		{forks.Deneb, c.Spec.DenebForkEpoch, c.Spec.DenebForkVersion},
+		{forks.Capella, c.Spec.CapellaForkEpoch, c.Spec.CapellaForkVersion},
+		{forks.Bellatrix, c.Spec.BellatrixForkEpoch, c.Spec.BellatrixForkVersion},
+		{forks.Altair, c.Spec.AltairForkEpoch, c.Spec.AltairForkVersion},
+	}
+	for _, fork := range schedule {
+		if fork.forkVersion != "" && fork.epoch <= epoch {
+			return fork.version
+		}
+	}
+	return forks.Phase0
+}
+
+// GenIndicesAt returns generalized indices of the fork active at the given slot
+func (c *LightClient) GenIndicesAt(slot uint64) (*GenIndices, error) {
+	version := c.forkAtSlot(slot)
+	gi, ok := forkGenIndices[version]
+	if !ok {
+		return nil, fmt.Errorf("proofs are not supported for %s fork at slot %d", version, slot)
+	}
+	return gi, nil
+}
+
+// genIndicesForState returns generalized indices for the given state, checking that its version matches the fork schedule
+func (c *LightClient) genIndicesForState(state *forks.BeaconState) (*GenIndices, error) {
+	gi, err := c.GenIndicesAt(state.Slot)
+	if err != nil {
+		return nil, err
+	}
+	if gi.Version != state.Version {
+		return nil, fmt.Errorf("beacon state at slot %d has %s version, while %s is expected by the fork schedule", state.Slot, state.Version, gi.Version)
+	}
+	return gi, nil
+}
+
+// checkContractGenIndex fails if the generalized index of the given field differs from the one expected by the contract
+func checkContractGenIndex(field string, gi *GenIndices, actual int, expected int) error {
+	if expected == 0 {
+		return fmt.Errorf("%s proofs at %s fork are not supported by the contract", field, gi.Version)
+	}
+	if actual != expected {
+		return fmt.Errorf("%s generalized index %d (depth %d) at %s fork doesn't match %d (depth %d) expected by the contract",
+			field, actual, genIndexDepth(actual), gi.Version, expected, genIndexDepth(expected))
+	}
+	return nil
+}
+
+func genIndexDepth(genIndex int) int {
+	return bits.Len(uint(genIndex)) - 1
+}
@@ -0,0 +1,114 @@
+package lightclient
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+func TestGenIndicesAt(t *testing.T) {
+	spec := *testSpec
+	spec.AltairForkVersion, spec.AltairForkEpoch = "0x01000000", 1
+	spec.BellatrixForkVersion, spec.BellatrixForkEpoch = "0x02000000", 2
+	spec.CapellaForkVersion, spec.CapellaForkEpoch = "0x03000000", 3
+	spec.DenebForkVersion, spec.DenebForkEpoch = "0x04000000", 4
+	spec.ElectraForkVersion, spec.ElectraForkEpoch = "0x05000000", 5
+	c := &LightClient{Spec: &spec}
+
+	_, err := c.GenIndicesAt(1 * 32)
+	assert.Error(t, err)
+
+	gi, err := c.GenIndicesAt(2*32 + 31)
+	require.NoError(t, err)
+	assert.Equal(t, ContractGenIndices, gi)
+	assert.Equal(t, 105, gi.FinalizedRoot())
+
+	gi, err = c.GenIndicesAt(4 * 32)
+	require.NoError(t, err)
+	assert.Equal(t, forks.Deneb, gi.Version)
+	assert.Equal(t, 56, gi.LatestExecutionPayloadHeader)
+	assert.Equal(t, 35, gi.PayloadReceiptsRoot)
+	assert.Error(t, checkContractGenIndex("receipts_root", gi, gi.PayloadReceiptsRoot, ContractGenIndices.PayloadReceiptsRoot))
+
+	// fulu is not scheduled
+	gi, err = c.GenIndicesAt(100 * 32)
+	require.NoError(t, err)
+	assert.Equal(t, forks.Electra, gi.Version)
+	assert.Equal(t, 169, gi.FinalizedRoot())
+	assert.Equal(t, 86, gi.CurrentSyncCommittee)
+	assert.Error(t, checkContractGenIndex("current_sync_committee", gi, gi.CurrentSyncCommittee, ContractGenIndices.CurrentSyncCommittee))
+}
+
+func TestProveField(t *testing.T) {
+	c := &LightClient{Spec: testSpec}
+	for version, raw := range map[forks.Version]forks.Object{
+		forks.Bellatrix: new(forks.BeaconStateBellatrix),
+		forks.Capella:   new(forks.BeaconStateCapella),
+		forks.Deneb:     new(forks.BeaconStateDeneb),
+		forks.Electra:   new(forks.BeaconStateElectra),
+		forks.Fulu:      new(forks.BeaconStateFulu),
+	} {
+		state := forks.NewBeaconState(version, newTestState(raw))
+		stateTree, err := c.makeBeaconStateTree(state)
+		require.NoError(t, err, version.String())
+		gi := forkGenIndices[version]
+
+		proof, leaf, err := c.proveStatePath(state, stateTree, "next_sync_committee")
+		require.NoError(t, err, version.String())
+		assert.Equal(t, gi.NextSyncCommittee, proof.GenIndex(), version.String())
+		assert.Equal(t, crypto.MustHashTreeRoot(state.NextSyncCommittee), leaf, version.String())
+		assert.Equal(t, stateTree.Hash(), proof.ReconstructRoot(leaf), version.String())
+
+		proof, leaf, err = c.proveStatePath(state, stateTree, "latest_execution_payload_header.receipts_root")
+		require.NoError(t, err, version.String())
+		assert.Equal(t, crypto.ConcatGenIndices(gi.LatestExecutionPayloadHeader, gi.PayloadReceiptsRoot), proof.GenIndex(), version.String())
+		assert.Equal(t, common.BytesToHash(state.LatestExecutionPayloadHeader.ReceiptsRoot), leaf, version.String())
+		assert.Equal(t, stateTree.Hash(), proof.ReconstructRoot(leaf), version.String())
+
+		_, _, err = c.proveStatePath(state, stateTree, fmt.Sprintf("state_roots.%d", c.Spec.SlotsPerHistoricalRoot))
+		assert.Error(t, err, version.String())
+		_, _, err = c.proveStatePath(state, stateTree, "historical_roots.3")
+		assert.Error(t, err, version.String())
+	}
+
+	// nested proofs are rejected for the tree of another state
+	state := forks.NewBeaconState(forks.Deneb, newTestState(new(forks.BeaconStateDeneb)))
+	_, _, err := c.proveStatePath(state, crypto.NewVectorMerkleTree(make([]common.Hash, 40)...), "latest_execution_payload_header.receipts_root")
+	assert.Error(t, err)
+}
+
+func TestGenIndicesMatchSchema(t *testing.T) {
+	c := &LightClient{Spec: testSpec}
+	genIndex := func(schema *crypto.SSZType, path ...interface{}) int {
+		res, err := schema.GenIndex(path...)
+		require.NoError(t, err)
+		return res
+	}
+	for version, gi := range forkGenIndices {
+		state := c.BeaconStateSchema(version)
+		payload := ExecutionPayloadHeaderSchema(version)
+		assert.Equal(t, genIndex(state, "StateRoots"), gi.StateRoots, version.String())
+		assert.Equal(t, genIndex(state, "HistoricalRoots"), gi.HistoricalRoots, version.String())
+		assert.Equal(t, genIndex(state, "FinalizedCheckpoint", "Root"), gi.FinalizedRoot(), version.String())
+		assert.Equal(t, genIndex(state, "CurrentSyncCommittee"), gi.CurrentSyncCommittee, version.String())
+		assert.Equal(t, genIndex(state, "NextSyncCommittee"), gi.NextSyncCommittee, version.String())
+		assert.Equal(t, genIndex(state, "LatestExecutionPayloadHeader"), gi.LatestExecutionPayloadHeader, version.String())
+		assert.Equal(t, genIndex(payload, "StateRoot"), gi.PayloadStateRoot, version.String())
+		assert.Equal(t, genIndex(payload, "ReceiptsRoot"), gi.PayloadReceiptsRoot, version.String())
+		if version >= forks.Capella {
+			assert.Equal(t, genIndex(state, "HistoricalSummaries"), gi.HistoricalSummaries, version.String())
+		}
+	}
+
+	// nested proofs go through the execution payload header down to the state root
+	state := forks.NewBeaconState(forks.Deneb, newTestState(new(forks.BeaconStateDeneb)))
+	proof, _, err := c.BeaconStateSchema(forks.Deneb).Prove(state, "LatestExecutionPayloadHeader", "StateRoot")
+	require.NoError(t, err)
+	assert.Equal(t, crypto.MustHashTreeRoot(state.Raw), proof.ReconstructRoot(common.BytesToHash(state.LatestExecutionPayloadHeader.StateRoot)))
+}
@@ -1,64 +1,114 @@
 package lightclient
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
-	ethpb2 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
 
 	"oracle/beaconclient"
 	"oracle/config"
 	"oracle/crypto"
+	"oracle/forks"
 )
 
-const (
-	MinSyncCommitteeParticipants = 10
-)
+const MinSyncCommitteeParticipants = 10
 
 type LightClient struct {
 	Client       beaconclient.Eth2Client
 	Spec         *config.SpecConfig
 	Genesis      *config.GenesisConfig
 	WithFinality bool
+	// UseLightClientAPI switches update generation to the standard light client beacon API endpoints,
+	// so that neither full beacon states nor debug API are required
+	UseLightClientAPI bool
+	// UpdateWindow is the number of signature blocks compared by IsBetterUpdate when choosing the next update,
+	// 0 takes the latest block with enough signatures
+	UpdateWindow uint64
+	// StateMerkleizer keeps merkle trees of the last merkleized beacon state, so that neighbouring states are rehashed incrementally.
+	// It is shared by copies of the light client, without it every state is merkleized from scratch.
+	StateMerkleizer *StateMerkleizer
+}
+
+// StateMerkleizer holds an incremental merkleizer per beacon state fork
+type StateMerkleizer struct {
+	mu          sync.Mutex
+	merkleizers map[forks.Version]*crypto.Merkleizer
 }
 
-func NewLightClient(cfg config.Eth2Config, finality bool) (*LightClient, error) {
+func NewStateMerkleizer() *StateMerkleizer {
+	return &StateMerkleizer{merkleizers: make(map[forks.Version]*crypto.Merkleizer)}
+}
+
+func (m *StateMerkleizer) get(version forks.Version, schema func(forks.Version) *crypto.SSZType) *crypto.Merkleizer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res, ok := m.merkleizers[version]
+	if !ok {
+		res = crypto.NewMerkleizer(schema(version))
+		m.merkleizers[version] = res
+	}
+	return res
+}
+
+func NewLightClient(ctx context.Context, cfg config.Eth2Config, finality bool) (*LightClient, error) {
+	client, err := newEth2Client(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("can't initialize light client: %w", err)
+	}
 	lc := &LightClient{
-		Client:       beaconclient.NewClient(cfg.Client.URL),
+		Client:       client,
 		Spec:         cfg.Spec,
 		Genesis:      cfg.Genesis,
 		WithFinality: finality,
+
+		UseLightClientAPI: cfg.LightClientAPI,
+		StateMerkleizer:   NewStateMerkleizer(),
 	}
 	if cfg.Spec == nil {
 		log.Println("Fetching chain spec")
-		spec, err := lc.Client.GetSpec()
+		spec, err := lc.Client.GetSpec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("can't initialize light client: %w", err)
 		}
 		lc.Spec = &config.SpecConfig{
 			SecondsPerSlot:               spec.SecondsPerSlot,
 			SlotsPerEpoch:                spec.SlotsPerEpoch,
+			GenesisForkVersion:           spec.GenesisForkVersion,
 			AltairForkEpoch:              spec.AltairForkEpoch,
 			AltairForkVersion:            spec.AltairForkVersion,
 			BellatrixForkEpoch:           spec.BellatrixForkEpoch,
 			BellatrixForkVersion:         spec.BellatrixForkVersion,
+			CapellaForkEpoch:             spec.CapellaForkEpoch,
+			CapellaForkVersion:           spec.CapellaForkVersion,
+			DenebForkEpoch:               spec.DenebForkEpoch,
+			DenebForkVersion:             spec.DenebForkVersion,
+			ElectraForkEpoch:             spec.ElectraForkEpoch,
+			ElectraForkVersion:           spec.ElectraForkVersion,
+			FuluForkEpoch:                spec.FuluForkEpoch,
+			FuluForkVersion:              spec.FuluForkVersion,
 			EpochsPerSyncCommitteePeriod: spec.EpochsPerSyncCommitteePeriod,
 			SyncCommitteeSize:            spec.SyncCommitteeSize,
 			ValidatorRegistryLimit:       spec.ValidatorRegistryLimit,
 			HistoricalRootsLimit:         spec.HistoricalRootsLimit,
 			EpochsPerEth1VotingPeriod:    spec.EpochsPerEth1VotingPeriod,
 			SlotsPerHistoricalRoot:       spec.SlotsPerHistoricalRoot,
+
+			PendingDepositsLimit:           spec.PendingDepositsLimit,
+			PendingPartialWithdrawalsLimit: spec.PendingPartialWithdrawalsLimit,
+			PendingConsolidationsLimit:     spec.PendingConsolidationsLimit,
 		}
 	}
 	if cfg.Genesis == nil {
 		log.Println("Fetching chain genesis info")
-		genesis, err := lc.Client.GetGenesis()
+		genesis, err := lc.Client.GetGenesis(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("can't initialize light client: %w", err)
 		}
@@ -74,7 +124,41 @@ func NewLightClient(cfg config.Eth2Config, finality bool) (*LightClient, error)
 	return lc, nil
 }
 
-func (c *LightClient) MakeUpdate(curSlot uint64, targetSlot uint64) (*Update, error) {
+func newEth2Client(cfg config.Eth2Config) (beaconclient.Eth2Client, error) {
+	urls := cfg.Client.Endpoints()
+	var client beaconclient.Eth2Client
+	if len(urls) == 1 {
+		client = beaconclient.NewClient(urls[0], cfg.Client)
+	} else {
+		clients := make([]beaconclient.Eth2Client, len(urls))
+		for i, url := range urls {
+			clients[i] = beaconclient.NewClient(url, cfg.Client)
+		}
+		client = beaconclient.NewMultiClient(cfg.BlockRootQuorum, clients...)
+	}
+	if cfg.Cache != nil && cfg.Cache.Dir != "" {
+		cached, err := beaconclient.NewCachedClient(client, cfg.Cache.Dir, cfg.Cache.MaxSizeMB<<20)
+		if err != nil {
+			return nil, err
+		}
+		return cached, nil
+	}
+	return client, nil
+}
+
+func (c *LightClient) MakeUpdate(ctx context.Context, curSlot uint64, targetSlot uint64) (*Update, error) {
+	return c.makeUpdate(ctx, curSlot, targetSlot, nil)
+}
+
+// makeUpdate reuses the finality proof, if it was already made for the attested block of the chosen signature block
+func (c *LightClient) makeUpdate(ctx context.Context, curSlot uint64, targetSlot uint64, finality *finalityProof) (*Update, error) {
+	if c.UseLightClientAPI {
+		if targetSlot > 0 {
+			return nil, fmt.Errorf("target slot is not supported in light client API mode")
+		}
+		return c.makeUpdateFromLightClientAPI(ctx, curSlot)
+	}
+
 	slotsPerPeriod := c.Spec.EpochsPerSyncCommitteePeriod * c.Spec.SlotsPerEpoch
 	curPeriodStart := curSlot - curSlot%slotsPerPeriod
 	nextPeriodEnd := curPeriodStart + 2*slotsPerPeriod - 1
@@ -94,62 +178,204 @@ func (c *LightClient) MakeUpdate(curSlot uint64, targetSlot uint64) (*Update, er
 		}
 	}
 
-	var head *ethpb2.BeaconBlockBellatrix
+	// the head state is shared by the candidate verification and the sync committee proof
+	cur := c.newLazyState(curSlot)
+	var head *forks.BeaconBlock
 	var err error
+	if targetSlot > 0 {
+		head, err = c.findSignatureBlock(ctx, slot, curSlot)
+	} else {
+		head, err = c.findBestSignatureBlock(ctx, slot, cur)
+	}
+	if err != nil || head == nil {
+		return nil, err
+	}
+
+	signatureSlot := head.Slot
+	attestedRoot := head.ParentRoot
+	log.Println("Fetching block for root ", attestedRoot)
+	attestedBlock, err := c.Client.GetBlock(ctx, attestedRoot.String())
+	if err != nil {
+		return nil, fmt.Errorf("can't get block %s: %w", attestedRoot, err)
+	}
+	attestedHeader := ConvertToHeader(attestedBlock)
+
+	curBlock, err := c.Client.GetBlock(ctx, strconv.FormatUint(curSlot, 10))
+	if err != nil {
+		return nil, fmt.Errorf("can't get block %d: %w", curSlot, err)
+	}
+
+	// blocks signed in the next period can only be verified with the next_sync_committee of the current state
+	var isNext bool
+	switch signatureSlot / slotsPerPeriod {
+	case curSlot / slotsPerPeriod:
+		isNext = false
+	case curSlot/slotsPerPeriod + 1:
+		isNext = true
+	default:
+		return nil, fmt.Errorf("signature slot %d is too far from the current slot %d", signatureSlot, curSlot)
+	}
+
+	log.Println("Fetching and proving sync committee", curSlot, signatureSlot)
+	// check that obtained sync committee is reflected in the current block state_root
+	cmt, proof, err := c.proveNewSyncCommittee(ctx, cur, curBlock.StateRoot, isNext)
+	if err != nil {
+		return nil, fmt.Errorf("can't prove sync committee: %w", err)
+	}
 
-	for {
+	update, err := c.makeSyncAggregateUpdate(cmt, head.SyncAggregate, attestedRoot, signatureSlot, head)
+	if err != nil {
+		return nil, err
+	}
+	update.AttestedHeader = attestedHeader
+	update.SyncCommitteeBranch = proof.Path
+	if c.WithFinality {
+		if finality == nil || finality.attestedRoot != attestedRoot {
+			log.Println("Fetching full beacon state for slot", attestedHeader.Slot)
+			state, stateTree, err := c.GetBeaconState(ctx, attestedHeader.Slot)
+			if err != nil {
+				return nil, fmt.Errorf("can't get finality beacon state: %w", err)
+			}
+			if finality, err = c.proveFinality(ctx, state, stateTree, attestedHeader); err != nil {
+				return nil, err
+			}
+		}
+		update.FinalizedHeader = finality.header
+		update.FinalityBranch = finality.branch
+
+		if update.FinalizedHeader.Slot <= curSlot {
+			return nil, nil
+		}
+	} else {
+		if attestedHeader.Slot <= curSlot {
+			return nil, nil
+		}
+	}
+
+	return update, nil
+}
+
+// finalityProof is the finalized header together with its branch from the state root of the attested block
+type finalityProof struct {
+	attestedRoot common.Hash
+	header       BeaconBlockHeader
+	branch       []common.Hash
+}
+
+// proveFinality proves the finalized checkpoint of the given attested beacon state
+func (c *LightClient) proveFinality(ctx context.Context, state *forks.BeaconState, stateTree *crypto.MerkleTree, attestedHeader BeaconBlockHeader) (*finalityProof, error) {
+	recStateRoot := stateTree.Hash()
+	if recStateRoot != attestedHeader.StateRoot {
+		return nil, c.invalidData(state, fmt.Errorf("failed to reconstruct given state root, %s != %s", recStateRoot, attestedHeader.StateRoot))
+	}
+	finalizedBlock, err := c.Client.GetBlock(ctx, hexutil.Encode(state.FinalizedCheckpoint.Root))
+	if err != nil {
+		return nil, fmt.Errorf("can't get finality block: %w", err)
+	}
+	gi, err := c.genIndicesForState(state)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkContractGenIndex("finalized_checkpoint.root", gi, gi.FinalizedRoot(), ContractGenIndices.FinalizedRoot()); err != nil {
+		return nil, err
+	}
+	proof, _, err := c.proveStatePath(state, stateTree, "finalized_checkpoint.root")
+	if err != nil {
+		return nil, fmt.Errorf("can't prove finalized checkpoint: %w", err)
+	}
+	return &finalityProof{
+		attestedRoot: attestedHeader.HashTreeRoot(),
+		header:       ConvertToHeader(finalizedBlock),
+		branch:       proof.Path,
+	}, nil
+}
+
+// findSignatureBlock walks back from the given slot until it finds a block with enough sync committee signatures,
+// nil is returned if there are no such blocks after curSlot
+func (c *LightClient) findSignatureBlock(ctx context.Context, slot uint64, curSlot uint64) (*forks.BeaconBlock, error) {
+	for ; slot > curSlot; slot-- {
 		log.Println("Fetching block for slot", slot)
-		head, err = c.Client.GetBlock(strconv.FormatUint(slot, 10))
+		head, err := c.Client.GetBlock(ctx, strconv.FormatUint(slot, 10))
 		if err != nil {
 			if errors.Is(err, beaconclient.NotFoundError) {
-				slot--
-				log.Println("Block does not exist, trying previous slot", slot)
+				log.Println("Block does not exist, trying previous slot", slot-1)
 				continue
 			}
 			return nil, fmt.Errorf("can't get block %d: %w", slot, err)
 		}
-		syncParticipants := head.Body.SyncAggregate.SyncCommitteeBits.Count()
+		syncParticipants := bitfield.Bitvector512(head.SyncAggregate.SyncCommitteeBits).Count()
 		if syncParticipants < MinSyncCommitteeParticipants || (c.WithFinality && 3*syncParticipants < 2*uint64(c.Spec.SyncCommitteeSize)) {
-			slot--
-			log.Println("Not enough sync committee signatures", slot)
+			log.Println("Not enough sync committee signatures", slot-1)
 			continue
 		}
 		participation := float64(syncParticipants) * 100 / float64(c.Spec.SyncCommitteeSize)
 		log.Printf("Chosen header with %d (%.2f%%) sync participants\n", syncParticipants, participation)
-		break
+		return head, nil
 	}
+	return nil, nil
+}
 
-	signatureSlot := uint64(head.Slot)
-	attestedRoot := common.BytesToHash(head.ParentRoot)
-	log.Println("Fetching block for root ", attestedRoot)
-	attestedBlock, err := c.Client.GetBlock(attestedRoot.String())
+// signatureSet aggregates the public keys of the sync aggregate participants and decodes the signature of the attested root,
+// source is the beacon node response with the sync aggregate
+func (c *LightClient) signatureSet(cmt *SyncCommittee, aggregate *forks.SyncAggregate, attestedRoot common.Hash, signatureSlot uint64, source interface{}) (*crypto.SignatureSet, error) {
+	if len(cmt.PublicKeys) != c.Spec.SyncCommitteeSize || len(aggregate.SyncCommitteeBits) != c.Spec.SyncCommitteeSize/8 {
+		return nil, c.invalidData(source, fmt.Errorf("sync committee of %d keys and %d bytes of sync committee bits don't match sync committee size %d",
+			len(cmt.PublicKeys), len(aggregate.SyncCommitteeBits), c.Spec.SyncCommitteeSize))
+	}
+	var pk *crypto.G1Point
+	bits := bitfield.Bitvector512(aggregate.SyncCommitteeBits)
+	for i := 0; i < c.Spec.SyncCommitteeSize; i++ {
+		if bits.BitAt(uint64(i)) {
+			pk = crypto.AddG1Points(pk, &cmt.PublicKeys[i])
+		}
+	}
+	if pk == nil {
+		return nil, fmt.Errorf("sync aggregate has no participants")
+	}
+	sig, err := crypto.DecodeSig(aggregate.SyncCommitteeSignature)
 	if err != nil {
-		return nil, fmt.Errorf("can't get block %s: %w", attestedRoot, err)
+		return nil, c.invalidData(source, fmt.Errorf("sync aggregate signature: %w", err))
 	}
-	attestedHeader := ConvertToHeader(attestedBlock)
+	return &crypto.SignatureSet{
+		Message:    attestedRoot,
+		DomainRoot: c.syncDomainRootForVersion(c.signatureForkVersion(signatureSlot)),
+		PublicKey:  *pk,
+		Signature:  sig,
+	}, nil
+}
 
-	curBlock, err := c.Client.GetBlock(strconv.FormatUint(curSlot, 10))
+// syncCommitteeAt returns the sync committee, that signs blocks at the signature slot, from the beacon state of the same or the previous period
+func (c *LightClient) syncCommitteeAt(state *forks.BeaconState, signatureSlot uint64) (*SyncCommittee, error) {
+	var cm *forks.SyncCommittee
+	switch c.period(signatureSlot) {
+	case c.period(state.Slot):
+		cm = state.CurrentSyncCommittee
+	case c.period(state.Slot) + 1:
+		cm = state.NextSyncCommittee
+	default:
+		return nil, fmt.Errorf("signature slot %d is too far from the state slot %d", signatureSlot, state.Slot)
+	}
+	cmt, err := ConvertToSyncCommittee(cm)
 	if err != nil {
-		return nil, fmt.Errorf("can't get block %d: %w", curSlot, err)
+		return nil, c.invalidData(state, fmt.Errorf("sync committee of state %d: %w", state.Slot, err))
 	}
+	return cmt, nil
+}
 
-	log.Println("Fetching and proving sync committee", curSlot, signatureSlot)
-	isNext := curSlot/slotsPerPeriod != curSlot/slotsPerPeriod
-	// check that obtained sync committee is reflected in the current block state_root
-	cmt, proof, err := c.proveNewSyncCommittee(curSlot, common.BytesToHash(curBlock.StateRoot), isNext)
+// makeSyncAggregateUpdate fills in the sync aggregate related parts of the update:
+// signature slot with its fork version, aggregated public key and signature, missed participants with their multiproof and reordered bitlist
+func (c *LightClient) makeSyncAggregateUpdate(cmt *SyncCommittee, aggregate *forks.SyncAggregate, attestedRoot common.Hash, signatureSlot uint64, source interface{}) (*Update, error) {
+	set, err := c.signatureSet(cmt, aggregate, attestedRoot, signatureSlot, source)
 	if err != nil {
-		return nil, fmt.Errorf("can't prove sync committee: %w", err)
+		return nil, err
 	}
-
-	var pk *crypto.G1Point
 	var missingPKs []crypto.G1PointCompressed
 	var hashedPublicKeys []common.Hash
 	var indices []int
+	bits := bitfield.Bitvector512(aggregate.SyncCommitteeBits)
 	for i := 0; i < c.Spec.SyncCommitteeSize; i++ {
 		hashedPublicKeys = append(hashedPublicKeys, crypto.HashG1PointCompressed(&cmt.PublicKeys[i]))
-		if head.Body.SyncAggregate.SyncCommitteeBits.BitAt(uint64(i)) {
-			pk = crypto.AddG1Points(pk, &cmt.PublicKeys[i])
-		} else {
+		if !bits.BitAt(uint64(i)) {
 			indices = append(indices, i)
 		}
 	}
@@ -158,56 +384,24 @@ func (c *LightClient) MakeUpdate(curSlot uint64, targetSlot uint64) (*Update, er
 	}
 	tree := crypto.NewVectorMerkleTree(hashedPublicKeys...)
 	multiProof := tree.MakeMultiProof(indices)
-	log.Printf("Verifying sync committee signature, aggregated pk = %s\n", pk.String())
+	log.Printf("Verifying sync committee signature, aggregated pk = %s\n", set.PublicKey.String())
 	// check that already known and proven sync committee signed some block header
-	sig := crypto.MustDecodeSig(head.Body.SyncAggregate.SyncCommitteeSignature)
-	if !crypto.Verify(attestedRoot, c.syncDomainRoot(), *pk, sig) {
-		return nil, fmt.Errorf("can't verify aggregate signature from sync committee")
+	forkVersion := c.signatureForkVersion(signatureSlot)
+	if !crypto.Verify(set.Message, set.DomainRoot, set.PublicKey, set.Signature) {
+		return nil, fmt.Errorf("can't verify aggregate signature from sync committee with fork version %x", forkVersion)
 	}
 
-	forkVersion := [4]byte{}
-	copy(forkVersion[:], common.FromHex(c.Spec.BellatrixForkVersion))
 	update := &Update{
+		SignatureSlot:                   signatureSlot,
 		ForkVersion:                     forkVersion,
-		SignatureSlot:                   uint64(head.Slot),
-		AttestedHeader:                  attestedHeader,
-		SyncAggregatePubkey:             *pk,
-		SyncAggregateSignature:          sig,
-		SyncCommitteeBranch:             proof.Path,
+		SyncAggregatePubkey:             set.PublicKey,
+		SyncAggregateSignature:          set.Signature,
 		FinalityBranch:                  []common.Hash{},
 		MissedSyncCommitteeParticipants: missingPKs,
 		SyncCommitteeRootDecommitments:  multiProof.Decommitments,
 	}
-	if c.WithFinality {
-		log.Println("Fetching full beacon state for slot", attestedHeader.Slot)
-		state, stateTree, err := c.GetBeaconState(attestedHeader.Slot)
-		if err != nil {
-			return nil, fmt.Errorf("can't get finality beacon state: %w", err)
-		}
-		recStateRoot := stateTree.Hash()
-		if recStateRoot != attestedHeader.StateRoot {
-			log.Fatalf("failed to reconstruct given state root, %s != %s\n", recStateRoot, attestedHeader.StateRoot)
-		}
-		finalizedBlock, err := c.Client.GetBlock(hexutil.Encode(state.FinalizedCheckpoint.Root))
-		if err != nil {
-			return nil, fmt.Errorf("can't get finality block: %w", err)
-		}
-		update.FinalizedHeader = ConvertToHeader(finalizedBlock)
-		update.FinalityBranch = append(
-			[]common.Hash{crypto.UintToHash(uint64(state.FinalizedCheckpoint.Epoch))},
-			stateTree.MakeProof(20).Path...,
-		)
-
-		if update.FinalizedHeader.Slot <= curSlot {
-			return nil, nil
-		}
-	} else {
-		if attestedHeader.Slot <= curSlot {
-			return nil, nil
-		}
-	}
 
-	bits := head.Body.SyncAggregate.SyncCommitteeBits.Bytes()
+	bits = bits.Bytes()
 	for w := 0; w < c.Spec.SyncCommitteeSize/256; w++ {
 		for k := 0; k < 16; k++ {
 			bits[w*32+k], bits[w*32+31-k] = bits[w*32+31-k], bits[w*32+k]
@@ -219,132 +413,220 @@ func (c *LightClient) MakeUpdate(curSlot uint64, targetSlot uint64) (*Update, er
 	return update, nil
 }
 
-func (c *LightClient) GetBeaconState(slot uint64) (*ethpb2.BeaconStateBellatrix, *crypto.MerkleTree, error) {
-	state, err := c.Client.GetState(slot)
+func (c *LightClient) GetBeaconState(ctx context.Context, slot uint64) (*forks.BeaconState, *crypto.MerkleTree, error) {
+	state, err := c.Client.GetState(ctx, slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	tree, err := c.beaconStateTree(slot, state)
 	if err != nil {
 		return nil, nil, err
 	}
+	return state, tree, nil
+}
+
+// beaconStateTree merkleizes the state of the given slot, reusing the field roots from the client cache if possible
+func (c *LightClient) beaconStateTree(slot uint64, state *forks.BeaconState) (*crypto.MerkleTree, error) {
+	cache, ok := c.Client.(beaconclient.StateFieldRootsCache)
+	if !ok {
+		return c.makeBeaconStateTree(state)
+	}
+	roots, ok := cache.GetStateFieldRoots(slot)
+	if !ok {
+		tree, err := c.makeBeaconStateTree(state)
+		if err != nil {
+			return nil, err
+		}
+		roots = tree.Leaves()
+		cache.PutStateFieldRoots(slot, roots)
+	}
+	return crypto.NewVectorMerkleTree(roots...), nil
+}
 
-	stateTree := crypto.NewVectorMerkleTree(
-		crypto.UintToHash(state.GenesisTime),
-		common.BytesToHash(state.GenesisValidatorsRoot),
-		crypto.UintToHash(uint64(state.Slot)),
-		crypto.MustHashTreeRoot(state.Fork),
-		crypto.MustHashTreeRoot(state.LatestBlockHeader),
-		crypto.HashRootsVector(state.BlockRoots),
-		crypto.HashRootsVector(state.StateRoots),
-		crypto.HashRootsList(state.HistoricalRoots, c.Spec.HistoricalRootsLimit),
-		crypto.MustHashTreeRoot(state.Eth1Data),
-		crypto.HashEth1Datas(state.Eth1DataVotes, int(c.Spec.SlotsPerEpoch*c.Spec.EpochsPerEth1VotingPeriod)),
-		crypto.UintToHash(state.Eth1DepositIndex),
-		crypto.HashValidators(state.Validators, c.Spec.ValidatorRegistryLimit),
-		crypto.HashUint64List(state.Balances, c.Spec.ValidatorRegistryLimit),
-		crypto.HashRootsVector(state.RandaoMixes),
-		crypto.HashUint64Vector(state.Slashings),
-		crypto.HashUint8List(state.PreviousEpochParticipation, c.Spec.ValidatorRegistryLimit),
-		crypto.HashUint8List(state.CurrentEpochParticipation, c.Spec.ValidatorRegistryLimit),
-		crypto.BytesToMerkleHash(state.JustificationBits.Bytes()),
-		crypto.MustHashTreeRoot(state.PreviousJustifiedCheckpoint),
-		crypto.MustHashTreeRoot(state.CurrentJustifiedCheckpoint),
-		crypto.MustHashTreeRoot(state.FinalizedCheckpoint),
-		crypto.HashUint64List(state.InactivityScores, c.Spec.ValidatorRegistryLimit),
-		crypto.MustHashTreeRoot(state.CurrentSyncCommittee),
-		crypto.MustHashTreeRoot(state.NextSyncCommittee),
-		crypto.MustHashTreeRoot(state.LatestExecutionPayloadHeader),
-	)
-	return state, stateTree, nil
+// lazyState downloads the beacon state of the slot on the first use, and merkleizes it only if the tree is needed,
+// so that the steps of a single update share one copy of the light client head state
+type lazyState struct {
+	c     *LightClient
+	slot  uint64
+	state *forks.BeaconState
+	tree  *crypto.MerkleTree
+}
+
+func (c *LightClient) newLazyState(slot uint64) *lazyState {
+	return &lazyState{c: c, slot: slot}
+}
+
+func (s *lazyState) get(ctx context.Context) (*forks.BeaconState, error) {
+	if s.state == nil {
+		state, err := s.c.Client.GetState(ctx, s.slot)
+		if err != nil {
+			return nil, fmt.Errorf("can't get beacon state %d: %w", s.slot, err)
+		}
+		s.state = state
+	}
+	return s.state, nil
 }
 
-func (c *LightClient) MakeExecutionPayloadStateRootProof(slot uint64) ([]common.Hash, error) {
-	state, stateTree, err := c.GetBeaconState(slot)
+func (s *lazyState) getWithTree(ctx context.Context) (*forks.BeaconState, *crypto.MerkleTree, error) {
+	state, err := s.get(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("can't get beacon state: %w", err)
+		return nil, nil, err
 	}
-	proof1 := stateTree.MakeProof(24)
+	if s.tree == nil {
+		if s.tree, err = s.c.beaconStateTree(s.slot, state); err != nil {
+			return nil, nil, err
+		}
+	}
+	return state, s.tree, nil
+}
 
-	payloadTree := makeExecutionPayloadTree(state.LatestExecutionPayloadHeader)
-	proof2 := payloadTree.MakeProof(2)
+func (c *LightClient) makeBeaconStateTree(state *forks.BeaconState) (*crypto.MerkleTree, error) {
+	var tree *crypto.MerkleTree
+	var err error
+	if c.StateMerkleizer != nil {
+		tree, err = c.StateMerkleizer.get(state.Version, c.BeaconStateSchema).MerkleTree(state)
+	} else {
+		tree, err = c.BeaconStateSchema(state.Version).MerkleTree(state)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't merkleize %s beacon state: %w", state.Version, err)
+	}
+	return tree, nil
+}
 
-	return append(proof2.Path, proof1.Path...), nil
+func (c *LightClient) MakeExecutionPayloadStateRootProof(ctx context.Context, slot uint64) ([]common.Hash, error) {
+	state, stateTree, err := c.GetBeaconState(ctx, slot)
+	if err != nil {
+		return nil, fmt.Errorf("can't get beacon state: %w", err)
+	}
+	gi, err := c.genIndicesForState(state)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkContractGenIndex("latest_execution_payload_header", gi, gi.LatestExecutionPayloadHeader, ContractGenIndices.LatestExecutionPayloadHeader); err != nil {
+		return nil, err
+	}
+	if err = checkContractGenIndex("execution state_root", gi, gi.PayloadStateRoot, ContractGenIndices.PayloadStateRoot); err != nil {
+		return nil, err
+	}
+	proof, _, err := c.proveStatePath(state, stateTree, "latest_execution_payload_header.state_root")
+	if err != nil {
+		return nil, fmt.Errorf("can't prove execution state root: %w", err)
+	}
+	return proof.Path, nil
 }
 
-func (c *LightClient) MakeExecutionPayloadReceiptsRootProof(sourceSlot, targetSlot uint64) ([]common.Hash, error) {
+func (c *LightClient) MakeExecutionPayloadReceiptsRootProof(ctx context.Context, sourceSlot, targetSlot uint64) ([]common.Hash, error) {
 	if sourceSlot < targetSlot {
 		return nil, fmt.Errorf("can't make proof for sourceSlot %d < targetSlot %d", sourceSlot, targetSlot)
 	}
-	sourceState, sourceStateTree, err := c.GetBeaconState(sourceSlot)
+	sourceState, sourceStateTree, err := c.GetBeaconState(ctx, sourceSlot)
 	if err != nil {
 		return nil, fmt.Errorf("can't get beacon state: %w", err)
 	}
-	targetState, targetStateTree, err := c.GetBeaconState(targetSlot)
+	targetState, targetStateTree, err := c.GetBeaconState(ctx, targetSlot)
 	if err != nil {
 		return nil, fmt.Errorf("can't get beacon state: %w", err)
 	}
+	sourceGI, err := c.genIndicesForState(sourceState)
+	if err != nil {
+		return nil, err
+	}
+	targetGI, err := c.genIndicesForState(targetState)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkContractGenIndex("latest_execution_payload_header", targetGI, targetGI.LatestExecutionPayloadHeader, ContractGenIndices.LatestExecutionPayloadHeader); err != nil {
+		return nil, err
+	}
+	if err = checkContractGenIndex("execution receipts_root", targetGI, targetGI.PayloadReceiptsRoot, ContractGenIndices.PayloadReceiptsRoot); err != nil {
+		return nil, err
+	}
 
-	var proof []common.Hash
+	// proofs from the source state root down to the target state root, followed by the receipts root proof
+	var proofs []*crypto.MerkleProof
 	if sourceSlot == targetSlot {
 		// do nothing
 	} else if targetSlot+c.Spec.SlotsPerHistoricalRoot > sourceSlot {
-		var hashes []common.Hash
-		for _, h := range sourceState.StateRoots {
-			hashes = append(hashes, common.BytesToHash(h))
+		if err = checkContractGenIndex("state_roots", sourceGI, sourceGI.StateRoots, ContractGenIndices.StateRoots); err != nil {
+			return nil, err
 		}
-		proof1 := crypto.NewVectorMerkleTree(hashes...).MakeProof(int(targetSlot) % len(sourceState.StateRoots))
-		proof2 := sourceStateTree.MakeProof(6)
-		proof = append(proof1.Path, proof2.Path...)
+		proof, _, err2 := c.proveStatePath(sourceState, sourceStateTree, fmt.Sprintf("state_roots.%d", targetSlot%c.Spec.SlotsPerHistoricalRoot))
+		if err2 != nil {
+			return nil, fmt.Errorf("can't prove state roots: %w", err2)
+		}
+		proofs = append(proofs, proof)
 	} else {
 		historicalRootIndex := targetSlot / c.Spec.SlotsPerHistoricalRoot
 		historicalBatchSlot := historicalRootIndex*c.Spec.SlotsPerHistoricalRoot + c.Spec.SlotsPerHistoricalRoot
 
-		historicalState, _, err2 := c.GetBeaconState(historicalBatchSlot)
+		historicalState, _, err2 := c.GetBeaconState(ctx, historicalBatchSlot)
 		if err2 != nil {
 			return nil, fmt.Errorf("can't get beacon state: %w", err2)
 		}
 
 		// state_root -> state_roots -> historical_root -> historical_roots -> state_root
-		var stateRoots, blockRoots, historicalRoots []common.Hash
-		for i := range historicalState.StateRoots {
-			stateRoots = append(stateRoots, common.BytesToHash(historicalState.StateRoots[i]))
-			blockRoots = append(blockRoots, common.BytesToHash(historicalState.BlockRoots[i]))
+		// after Capella, historical_roots are frozen and new batches are accumulated in historical_summaries:
+		// state_root -> state_roots -> historical_summary -> historical_summaries -> state_root
+		field, historicalRootsIndex, expectedIndex := "historical_roots", sourceGI.HistoricalRoots, ContractGenIndices.HistoricalRoots
+		historicalRootsLen := uint64(len(sourceState.HistoricalRoots))
+		if frozen := historicalRootsLen; sourceState.Version >= forks.Capella && historicalRootIndex >= frozen {
+			historicalRootIndex -= frozen
+			historicalRootsLen = uint64(len(sourceState.HistoricalSummaries))
+			field, historicalRootsIndex, expectedIndex = "historical_summaries", sourceGI.HistoricalSummaries, ContractGenIndices.HistoricalSummaries
 		}
-		for _, h := range sourceState.HistoricalRoots {
-			historicalRoots = append(historicalRoots, common.BytesToHash(h))
+		if historicalRootIndex >= historicalRootsLen {
+			return nil, fmt.Errorf("historical root for slot %d is not yet available in state %d", targetSlot, sourceSlot)
 		}
-		proof1 := crypto.NewVectorMerkleTree(stateRoots...).MakeProof(int(targetSlot) % len(historicalState.StateRoots))
-		proof2 := crypto.NewListMerkleTree(historicalRoots, c.Spec.HistoricalRootsLimit).MakeProof(int(historicalRootIndex))
-		proof3 := sourceStateTree.MakeProof(7)
-
-		proof = append(proof1.Path, crypto.NewVectorMerkleTree(blockRoots...).Hash())
-		proof = append(proof, proof2.Path...)
-		proof = append(proof, proof3.Path...)
+		if err = checkContractGenIndex(field, sourceGI, historicalRootsIndex, expectedIndex); err != nil {
+			return nil, err
+		}
+		historicalRootProof, _, err2 := c.proveStatePath(sourceState, sourceStateTree, fmt.Sprintf("%s.%d", field, historicalRootIndex))
+		if err2 != nil {
+			return nil, fmt.Errorf("can't prove %s: %w", field, err2)
+		}
+		// historical summary holds the same roots, as the historical batch
+		batchProof, _, err2 := c.historicalBatchSchema().Prove(historicalState, "state_roots", int(targetSlot%c.Spec.SlotsPerHistoricalRoot))
+		if err2 != nil {
+			return nil, fmt.Errorf("can't prove historical batch: %w", err2)
+		}
+		proofs = append(proofs, historicalRootProof, batchProof)
 	}
 
-	payloadTree := makeExecutionPayloadTree(targetState.LatestExecutionPayloadHeader)
-	proof = append(targetStateTree.MakeProof(24).Path, proof...)
-	proof = append(payloadTree.MakeProof(3).Path, proof...)
-	return proof, nil
+	receiptsRootProof, receiptsRoot, err := c.proveStatePath(targetState, targetStateTree, "latest_execution_payload_header.receipts_root")
+	if err != nil {
+		return nil, fmt.Errorf("can't prove execution receipts root: %w", err)
+	}
+	proof := crypto.ConcatProofs(append(proofs, receiptsRootProof)...)
+	if err = proof.Verify(receiptsRoot, sourceStateTree.Hash()); err != nil {
+		return nil, fmt.Errorf("can't verify execution receipts root proof: %w", err)
+	}
+	return proof.Path, nil
 }
 
-func (c *LightClient) FindBeaconBlockByExecutionBlockNumber(blockNumber uint64) (uint64, error) {
+func (c *LightClient) FindBeaconBlockByExecutionBlockNumber(ctx context.Context, blockNumber uint64) (uint64, error) {
 	log.Printf("Looking for beacon block with execution payload block %d\n", blockNumber)
-	block, err := c.Client.GetBlock("head")
+	block, err := c.Client.GetBlock(ctx, "head")
 	if err != nil {
 		return 0, fmt.Errorf("can't get latest block: %w", err)
 	}
 
-	if block.Body.ExecutionPayload == nil {
+	if block.ExecutionPayload.BlockNumber == 0 {
 		return 0, fmt.Errorf("latest beacon block at slot %d has empty execution payload", block.Slot)
 	}
-	latestExecutionBlock := block.Body.ExecutionPayload.BlockNumber
+	latestExecutionBlock := block.ExecutionPayload.BlockNumber
 	if latestExecutionBlock < blockNumber {
 		return 0, fmt.Errorf("latest execution block number %d is less than target block number %d", latestExecutionBlock, blockNumber)
 	}
-	l, r := c.Spec.BellatrixForkEpoch*c.Spec.SlotsPerEpoch, uint64(block.Slot)
+	l, r := c.Spec.BellatrixForkEpoch*c.Spec.SlotsPerEpoch, block.Slot
 	for l < r {
 		m := (l + r) / 2
 		for s := uint64(1); m >= l && m <= r; s++ {
-			block, err = c.Client.GetBlock(strconv.FormatUint(m, 10))
+			block, err = c.Client.GetBlock(ctx, strconv.FormatUint(m, 10))
 			if err != nil {
+				if !errors.Is(err, beaconclient.NotFoundError) {
+					return 0, fmt.Errorf("can't get beacon block at slot %d: %w", m, err)
+				}
 				log.Printf("can't get beacon block at slot %d\n", m)
 				if s%2 == 0 {
 					m += s
@@ -359,7 +641,7 @@ func (c *LightClient) FindBeaconBlockByExecutionBlockNumber(blockNumber uint64)
 			l = r
 			break
 		}
-		if block.Body.ExecutionPayload == nil || block.Body.ExecutionPayload.BlockNumber < blockNumber {
+		if block.ExecutionPayload.BlockNumber < blockNumber {
 			l = m + 1
 		} else {
 			r = m
@@ -368,49 +650,67 @@ func (c *LightClient) FindBeaconBlockByExecutionBlockNumber(blockNumber uint64)
 	return l, nil
 }
 
-func (c *LightClient) proveNewSyncCommittee(slot uint64, stateRoot common.Hash, next bool) (*SyncCommittee, *crypto.MerkleProof, error) {
-	state, stateTree, err := c.GetBeaconState(slot)
+func (c *LightClient) proveNewSyncCommittee(ctx context.Context, cur *lazyState, stateRoot common.Hash, next bool) (*SyncCommittee, *crypto.MerkleProof, error) {
+	state, stateTree, err := cur.getWithTree(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	gi, err := c.genIndicesForState(state)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't get beacon state: %w", err)
+		return nil, nil, err
 	}
-	index := 22
+	field, index, expectedIndex := "current_sync_committee", gi.CurrentSyncCommittee, ContractGenIndices.CurrentSyncCommittee
 	cmt := state.CurrentSyncCommittee
 	if next {
 		log.Println("Constructing a merkle proof for next_sync_committee generalized index")
-		index = 23
+		field, index, expectedIndex = "next_sync_committee", gi.NextSyncCommittee, ContractGenIndices.NextSyncCommittee
 		cmt = state.NextSyncCommittee
 	}
-	proof := stateTree.MakeProof(index)
-	if proof.ReconstructRoot(crypto.MustHashTreeRoot(cmt)) != stateRoot {
-		return nil, nil, fmt.Errorf("failed to verify merkle proof against state_root")
+	if err = checkContractGenIndex(field, gi, index, expectedIndex); err != nil {
+		return nil, nil, err
+	}
+	proof, leaf, err := c.proveStatePath(state, stateTree, field)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = proof.Verify(leaf, stateRoot); err != nil {
+		return nil, nil, c.invalidData(state, fmt.Errorf("failed to verify merkle proof against state_root: %w", err))
 	}
-	log.Println("Current sync committee is verified against given state root")
-	return ConvertToSyncCommittee(cmt), proof, nil
+	log.Printf("Sync committee %s is verified against given state root\n", field)
+	committee, err := ConvertToSyncCommittee(cmt)
+	if err != nil {
+		return nil, nil, c.invalidData(state, fmt.Errorf("%s: %w", field, err))
+	}
+	return committee, proof, nil
+}
+
+// invalidData wraps the error of malformed beacon node data into InvalidDataError,
+// and reports the responses with such data to the client, so that it can move to another beacon node
+func (c *LightClient) invalidData(response interface{}, err error) error {
+	c.reportInvalidData(response)
+	return fmt.Errorf("%w: %s", beaconclient.InvalidDataError, err)
+}
+
+func (c *LightClient) reportInvalidData(response interface{}) {
+	if reporter, ok := c.Client.(beaconclient.InvalidDataReporter); ok {
+		reporter.ReportInvalidData(response)
+	}
+}
+
+// signatureForkVersion returns the version of the fork, that signs sync aggregates included at the given slot.
+// As specified by the Altair light client sync protocol, it is the fork active at signature_slot - 1.
+func (c *LightClient) signatureForkVersion(signatureSlot uint64) [4]byte {
+	if signatureSlot > 0 {
+		signatureSlot--
+	}
+	return c.Spec.ForkVersionAt(signatureSlot / c.Spec.SlotsPerEpoch)
 }
 
-func (c *LightClient) syncDomainRoot() common.Hash {
+func (c *LightClient) syncDomainRootForVersion(version [4]byte) common.Hash {
 	res := common.Hash{7, 0, 0, 0}
-	forkVersion := crypto.HexToMerkleHash(c.Spec.BellatrixForkVersion)
+	forkVersion := common.Hash{}
+	copy(forkVersion[:], version[:])
 	forkRoot := crypto.Sha256Hash(forkVersion.Bytes(), c.Genesis.GenesisValidatorsRoot.Bytes())
 	copy(res[4:], forkRoot[:28])
 	return res
 }
-
-func makeExecutionPayloadTree(payload *ethpb2.ExecutionPayloadHeader) *crypto.MerkleTree {
-	return crypto.NewVectorMerkleTree(
-		common.BytesToHash(payload.ParentHash),
-		common.BytesToHash(bytesutil.PadTo(payload.FeeRecipient, 32)),
-		common.BytesToHash(payload.StateRoot),
-		common.BytesToHash(payload.ReceiptsRoot),
-		crypto.BytesToMerkleHash(payload.LogsBloom),
-		common.BytesToHash(payload.PrevRandao),
-		crypto.UintToHash(payload.BlockNumber),
-		crypto.UintToHash(payload.GasLimit),
-		crypto.UintToHash(payload.GasUsed),
-		crypto.UintToHash(payload.Timestamp),
-		crypto.HashUint8List(payload.ExtraData, 32),
-		common.BytesToHash(payload.BaseFeePerGas),
-		common.BytesToHash(payload.BlockHash),
-		common.BytesToHash(payload.TransactionsRoot),
-	)
-}
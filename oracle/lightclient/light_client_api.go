@@ -0,0 +1,196 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/go-bitfield"
+
+	"oracle/beaconclient"
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+// makeUpdateFromLightClientAPI builds an update from the spec light client objects,
+// served by /eth/v1/beacon/light_client/* endpoints, instead of full beacon states.
+func (c *LightClient) makeUpdateFromLightClientAPI(ctx context.Context, curSlot uint64) (*Update, error) {
+	slotsPerPeriod := c.Spec.EpochsPerSyncCommitteePeriod * c.Spec.SlotsPerEpoch
+	curPeriod := curSlot / slotsPerPeriod
+	clockSlot := uint64(time.Since(c.Genesis.GenesisTime).Seconds()) / c.Spec.SecondsPerSlot
+	clockPeriod := clockSlot / slotsPerPeriod
+
+	var data *beaconclient.ModelLightClientUpdateData
+	var err error
+	if clockPeriod > curPeriod+1 {
+		// latest updates are signed by the sync committee unknown to the current head,
+		// so we need to take the best update from the next period first
+		log.Println("Fetching light client update for period", curPeriod+1)
+		var updates []*beaconclient.ModelLightClientUpdateData
+		updates, err = c.Client.GetLightClientUpdates(ctx, curPeriod+1, 1)
+		if err == nil && len(updates) == 0 {
+			err = fmt.Errorf("no updates were returned for period %d", curPeriod+1)
+		}
+		if err == nil {
+			data = updates[0]
+		}
+	} else if c.WithFinality {
+		log.Println("Fetching light client finality update")
+		data, err = c.Client.GetLightClientFinalityUpdate(ctx)
+	} else {
+		log.Println("Fetching light client optimistic update")
+		data, err = c.Client.GetLightClientOptimisticUpdate(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't get light client update: %w", err)
+	}
+
+	attestedHeader := ConvertModelToHeader(&data.AttestedHeader)
+	if c.WithFinality {
+		if data.FinalizedHeader == nil || data.FinalizedHeader.Beacon.Slot <= curSlot {
+			return nil, nil
+		}
+	} else if attestedHeader.Slot <= curSlot {
+		return nil, nil
+	}
+
+	aggregate := ConvertModelToSyncAggregate(&data.SyncAggregate)
+	syncParticipants := bitfield.Bitvector512(aggregate.SyncCommitteeBits).Count()
+	if syncParticipants < MinSyncCommitteeParticipants || (c.WithFinality && 3*syncParticipants < 2*uint64(c.Spec.SyncCommitteeSize)) {
+		log.Printf("Not enough sync committee signatures in update for slot %d: %d\n", data.SignatureSlot, syncParticipants)
+		return nil, nil
+	}
+	participation := float64(syncParticipants) * 100 / float64(c.Spec.SyncCommitteeSize)
+	log.Printf("Chosen header with %d (%.2f%%) sync participants\n", syncParticipants, participation)
+
+	var isNext bool
+	switch data.SignatureSlot / slotsPerPeriod {
+	case curPeriod:
+		isNext = false
+	case curPeriod + 1:
+		isNext = true
+	default:
+		return nil, fmt.Errorf("signature slot %d is too far from the current slot %d", data.SignatureSlot, curSlot)
+	}
+
+	log.Println("Fetching and proving sync committee", curSlot, data.SignatureSlot)
+	cmt, proof, err := c.proveSyncCommitteeFromBootstrap(ctx, curSlot, isNext)
+	if err != nil {
+		return nil, fmt.Errorf("can't prove sync committee: %w", err)
+	}
+
+	update, err := c.makeSyncAggregateUpdate(cmt, aggregate, attestedHeader.HashTreeRoot(), data.SignatureSlot, data)
+	if err != nil {
+		return nil, err
+	}
+	update.AttestedHeader = attestedHeader
+	update.SyncCommitteeBranch = proof.Path
+
+	if c.WithFinality {
+		update.FinalizedHeader = ConvertModelToHeader(data.FinalizedHeader)
+		gi, err := c.GenIndicesAt(attestedHeader.Slot)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkContractGenIndex("finalized_checkpoint.root", gi, gi.FinalizedRoot(), ContractGenIndices.FinalizedRoot()); err != nil {
+			return nil, err
+		}
+		finalityProof := crypto.NewMerkleProof(gi.FinalizedRoot(), data.FinalityBranch)
+		if len(data.FinalityBranch) != genIndexDepth(gi.FinalizedRoot()) || finalityProof.ReconstructRoot(update.FinalizedHeader.HashTreeRoot()) != attestedHeader.StateRoot {
+			return nil, c.invalidData(data, fmt.Errorf("failed to verify finality branch against attested state_root"))
+		}
+		update.FinalityBranch = data.FinalityBranch
+	}
+
+	return update, nil
+}
+
+// proveSyncCommitteeFromBootstrap proves current or next sync committee against the state root of the block at given slot.
+// Bootstrap contains only the current_sync_committee branch, however, since current_sync_committee and
+// next_sync_committee are siblings in the state tree, the next_sync_committee branch differs only in its first element.
+func (c *LightClient) proveSyncCommitteeFromBootstrap(ctx context.Context, slot uint64, next bool) (*SyncCommittee, *crypto.MerkleProof, error) {
+	block, err := c.Client.GetBlock(ctx, strconv.FormatUint(slot, 10))
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get block %d: %w", slot, err)
+	}
+	header := ConvertToHeader(block)
+	bootstrap, err := c.Client.GetLightClientBootstrap(ctx, header.HashTreeRoot())
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get bootstrap for block %d: %w", slot, err)
+	}
+	if ConvertModelToHeader(&bootstrap.Header) != header {
+		return nil, nil, c.invalidData(bootstrap, fmt.Errorf("bootstrap header does not match requested block %d", slot))
+	}
+	gi, err := c.GenIndicesAt(slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(bootstrap.CurrentSyncCommitteeBranch) != genIndexDepth(gi.CurrentSyncCommittee) {
+		return nil, nil, c.invalidData(bootstrap, fmt.Errorf("invalid current_sync_committee_branch length %d", len(bootstrap.CurrentSyncCommitteeBranch)))
+	}
+	field, index, expectedIndex := "current_sync_committee", gi.CurrentSyncCommittee, ContractGenIndices.CurrentSyncCommittee
+	if next {
+		field, index, expectedIndex = "next_sync_committee", gi.NextSyncCommittee, ContractGenIndices.NextSyncCommittee
+	}
+	if err = checkContractGenIndex(field, gi, index, expectedIndex); err != nil {
+		return nil, nil, err
+	}
+
+	cmt := ConvertModelToSyncCommittee(&bootstrap.CurrentSyncCommittee)
+	cmtRoot, err := c.syncCommitteeRoot(cmt)
+	if err != nil {
+		return nil, nil, c.invalidData(bootstrap, fmt.Errorf("current_sync_committee: %w", err))
+	}
+	var source interface{} = bootstrap
+	proof := crypto.NewMerkleProof(index, bootstrap.CurrentSyncCommitteeBranch)
+	if next {
+		log.Println("Constructing a merkle proof for next_sync_committee generalized index")
+		period := slot / (c.Spec.EpochsPerSyncCommitteePeriod * c.Spec.SlotsPerEpoch)
+		updates, err := c.Client.GetLightClientUpdates(ctx, period, 1)
+		if err != nil {
+			return nil, nil, fmt.Errorf("can't get light client update for period %d: %w", period, err)
+		}
+		if len(updates) == 0 || updates[0].NextSyncCommittee == nil {
+			return nil, nil, fmt.Errorf("no next sync committee were returned for period %d", period)
+		}
+		path := append([]common.Hash{cmtRoot}, bootstrap.CurrentSyncCommitteeBranch[1:]...)
+		cmt = ConvertModelToSyncCommittee(updates[0].NextSyncCommittee)
+		source = updates[0]
+		if cmtRoot, err = c.syncCommitteeRoot(cmt); err != nil {
+			return nil, nil, c.invalidData(source, fmt.Errorf("next_sync_committee: %w", err))
+		}
+		proof = crypto.NewMerkleProof(index, path)
+	}
+	if proof.ReconstructRoot(cmtRoot) != header.StateRoot {
+		return nil, nil, c.invalidData(source, fmt.Errorf("failed to verify merkle proof against state_root"))
+	}
+	log.Println("Sync committee is verified against given state root")
+	committee, err := ConvertToSyncCommittee(cmt)
+	if err != nil {
+		return nil, nil, c.invalidData(source, fmt.Errorf("sync committee: %w", err))
+	}
+	return committee, proof, nil
+}
+
+// syncCommitteeRoot checks the sizes of the sync committee decoded from the beacon node JSON and returns its hash tree root
+func (c *LightClient) syncCommitteeRoot(cmt *forks.SyncCommittee) (common.Hash, error) {
+	if len(cmt.Pubkeys) != int(c.Spec.SyncCommitteeSize) {
+		return common.Hash{}, fmt.Errorf("expected %d public keys, got %d", c.Spec.SyncCommitteeSize, len(cmt.Pubkeys))
+	}
+	for i, pk := range cmt.Pubkeys {
+		if len(pk) != 48 {
+			return common.Hash{}, fmt.Errorf("public key %d has invalid length %d", i, len(pk))
+		}
+	}
+	if len(cmt.AggregatePubkey) != 48 {
+		return common.Hash{}, fmt.Errorf("aggregate public key has invalid length %d", len(cmt.AggregatePubkey))
+	}
+	root, err := cmt.HashTreeRoot()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return root, nil
+}
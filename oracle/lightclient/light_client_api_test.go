@@ -0,0 +1,280 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/beaconclient"
+	"oracle/forks"
+	"oracle/testchain"
+)
+
+// lightClientAPIChain serves the light client API endpoints of the synthetic chain,
+// objects are built from the full beacon states in the same way as beacon nodes build them
+type lightClientAPIChain struct {
+	*testchain.Chain
+	states *LightClient
+	// corrupt modifies every returned update, if set
+	corrupt func(update *beaconclient.ModelLightClientUpdateData)
+	reports []interface{}
+}
+
+func newLightClientAPIChain(chain *testchain.Chain) *lightClientAPIChain {
+	return &lightClientAPIChain{
+		Chain:  chain,
+		states: &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis},
+	}
+}
+
+func (c *lightClientAPIChain) GetLightClientBootstrap(ctx context.Context, blockRoot common.Hash) (*beaconclient.ModelLightClientBootstrapData, error) {
+	block, err := c.GetBlock(ctx, blockRoot.String())
+	if err != nil {
+		return nil, err
+	}
+	state, tree, err := c.states.GetBeaconState(ctx, block.Slot)
+	if err != nil {
+		return nil, err
+	}
+	proof, _, err := c.states.proveStatePath(state, tree, "current_sync_committee")
+	if err != nil {
+		return nil, err
+	}
+	return &beaconclient.ModelLightClientBootstrapData{
+		Header:                     modelHeader(block),
+		CurrentSyncCommittee:       modelSyncCommittee(state.CurrentSyncCommittee),
+		CurrentSyncCommitteeBranch: proof.Path,
+	}, nil
+}
+
+// GetLightClientUpdates takes the latest block of each period, which attests a block of the same period
+func (c *lightClientAPIChain) GetLightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*beaconclient.ModelLightClientUpdateData, error) {
+	slotsPerPeriod := c.Spec.EpochsPerSyncCommitteePeriod * c.Spec.SlotsPerEpoch
+	head, err := c.GetBlock(ctx, "head")
+	if err != nil {
+		return nil, err
+	}
+	var res []*beaconclient.ModelLightClientUpdateData
+	for period := startPeriod; period < startPeriod+count; period++ {
+		slot := (period+1)*slotsPerPeriod - 1
+		if slot > head.Slot {
+			slot = head.Slot
+		}
+		for ; slot > period*slotsPerPeriod; slot-- {
+			block, err := c.GetBlock(ctx, strconv.FormatUint(slot, 10))
+			if err != nil {
+				continue
+			}
+			update, err := c.makeUpdate(ctx, block, true, true)
+			if err != nil {
+				return nil, err
+			}
+			if update.AttestedHeader.Beacon.Slot >= period*slotsPerPeriod {
+				res = append(res, update)
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func (c *lightClientAPIChain) GetLightClientFinalityUpdate(ctx context.Context) (*beaconclient.ModelLightClientUpdateData, error) {
+	head, err := c.GetBlock(ctx, "head")
+	if err != nil {
+		return nil, err
+	}
+	return c.makeUpdate(ctx, head, true, false)
+}
+
+func (c *lightClientAPIChain) GetLightClientOptimisticUpdate(ctx context.Context) (*beaconclient.ModelLightClientUpdateData, error) {
+	head, err := c.GetBlock(ctx, "head")
+	if err != nil {
+		return nil, err
+	}
+	return c.makeUpdate(ctx, head, false, false)
+}
+
+func (c *lightClientAPIChain) ReportInvalidData(response interface{}) {
+	c.reports = append(c.reports, response)
+}
+
+func (c *lightClientAPIChain) makeUpdate(ctx context.Context, signature *forks.BeaconBlock, finality bool, next bool) (*beaconclient.ModelLightClientUpdateData, error) {
+	attested, err := c.GetBlock(ctx, signature.ParentRoot.String())
+	if err != nil {
+		return nil, err
+	}
+	res := &beaconclient.ModelLightClientUpdateData{
+		AttestedHeader: modelHeader(attested),
+		SyncAggregate: beaconclient.ModelSyncAggregate{
+			SyncCommitteeBits:      signature.SyncAggregate.SyncCommitteeBits,
+			SyncCommitteeSignature: signature.SyncAggregate.SyncCommitteeSignature,
+		},
+		SignatureSlot: signature.Slot,
+	}
+	state, tree, err := c.states.GetBeaconState(ctx, attested.Slot)
+	if err != nil {
+		return nil, err
+	}
+	if finality {
+		finalized, err := c.GetBlock(ctx, hexutil.Encode(state.FinalizedCheckpoint.Root))
+		if err != nil {
+			return nil, fmt.Errorf("can't get finalized block of slot %d: %w", attested.Slot, err)
+		}
+		proof, _, err := c.states.proveStatePath(state, tree, "finalized_checkpoint.root")
+		if err != nil {
+			return nil, err
+		}
+		header := modelHeader(finalized)
+		res.FinalizedHeader = &header
+		res.FinalityBranch = proof.Path
+	}
+	if next {
+		proof, _, err := c.states.proveStatePath(state, tree, "next_sync_committee")
+		if err != nil {
+			return nil, err
+		}
+		cmt := modelSyncCommittee(state.NextSyncCommittee)
+		res.NextSyncCommittee = &cmt
+		res.NextSyncCommitteeBranch = proof.Path
+	}
+	if c.corrupt != nil {
+		c.corrupt(res)
+	}
+	return res, nil
+}
+
+func modelHeader(block *forks.BeaconBlock) beaconclient.ModelLightClientHeader {
+	return beaconclient.ModelLightClientHeader{Beacon: beaconclient.ModelBeaconBlockHeader{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		StateRoot:     block.StateRoot,
+		BodyRoot:      block.BodyRoot,
+	}}
+}
+
+func modelSyncCommittee(cm *forks.SyncCommittee) beaconclient.ModelSyncCommittee {
+	pubkeys := make([]hexutil.Bytes, len(cm.Pubkeys))
+	for i, pk := range cm.Pubkeys {
+		pubkeys[i] = pk
+	}
+	return beaconclient.ModelSyncCommittee{Pubkeys: pubkeys, AggregatePubkey: cm.AggregatePubkey}
+}
+
+// newChainAt makes a chain, which head is at the current wall clock slot
+func newChainAt(t *testing.T, cfg testchain.Config, head uint64) *testchain.Chain {
+	secondsPerSlot := testchain.DefaultSpec().SecondsPerSlot
+	cfg.GenesisTime = time.Now().Add(-time.Duration(head*secondsPerSlot) * time.Second)
+	chain, err := testchain.NewChain(cfg)
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(head))
+	return chain
+}
+
+func TestMakeUpdateFromLightClientAPI(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		head     uint64
+		curSlot  uint64
+		finality bool
+	}{
+		// the latest update is signed by the current sync committee, proven by the bootstrap branch
+		{name: "finality update", head: 29, curSlot: 0, finality: true},
+		{name: "optimistic update", head: 29, curSlot: 8, finality: false},
+		// the best update of the next period is signed by next_sync_committee,
+		// its branch is spliced from the bootstrap branch and the next committee of the current period update
+		{name: "next period update", head: 84, curSlot: 8, finality: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newChainAt(t, testchain.Config{Participation: func(slot uint64) int { return 400 }}, tt.head)
+			fromStates := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: tt.finality}
+			fromAPI := &LightClient{Client: newLightClientAPIChain(chain), Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: tt.finality, UseLightClientAPI: true}
+
+			expected, err := fromStates.MakeUpdate(ctx, tt.curSlot, 0)
+			require.NoError(t, err)
+			require.NotNil(t, expected)
+			update, err := fromAPI.MakeUpdate(ctx, tt.curSlot, 0)
+			require.NoError(t, err)
+			assert.Equal(t, expected, update)
+		})
+	}
+}
+
+func TestMakeUpdateFromLightClientAPIInvalidData(t *testing.T) {
+	ctx := context.Background()
+	chain := newChainAt(t, testchain.Config{}, 84)
+	client := newLightClientAPIChain(chain)
+	c := &LightClient{Client: client, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true, UseLightClientAPI: true}
+
+	client.corrupt = func(update *beaconclient.ModelLightClientUpdateData) {
+		update.FinalityBranch[0][0] ^= 1
+	}
+	_, err := c.MakeUpdate(ctx, 8, 0)
+	require.ErrorIs(t, err, beaconclient.InvalidDataError)
+	assert.Contains(t, err.Error(), "finality branch")
+	require.Len(t, client.reports, 1)
+	assert.EqualValues(t, 63, client.reports[0].(*beaconclient.ModelLightClientUpdateData).SignatureSlot)
+
+	// next_sync_committee of the current period update doesn't match the spliced branch
+	client.reports = nil
+	client.corrupt = func(update *beaconclient.ModelLightClientUpdateData) {
+		if update.NextSyncCommittee != nil {
+			update.NextSyncCommittee.Pubkeys[0], update.NextSyncCommittee.Pubkeys[1] = update.NextSyncCommittee.Pubkeys[1], update.NextSyncCommittee.Pubkeys[0]
+		}
+	}
+	_, err = c.MakeUpdate(ctx, 8, 0)
+	require.ErrorIs(t, err, beaconclient.InvalidDataError)
+	require.Len(t, client.reports, 1)
+	assert.EqualValues(t, 31, client.reports[0].(*beaconclient.ModelLightClientUpdateData).SignatureSlot)
+}
+
+// shortCommitteeChain serves bootstraps with sync committees, that miss the last public key
+type shortCommitteeChain struct {
+	*lightClientAPIChain
+}
+
+func (c *shortCommitteeChain) GetLightClientBootstrap(ctx context.Context, blockRoot common.Hash) (*beaconclient.ModelLightClientBootstrapData, error) {
+	res, err := c.lightClientAPIChain.GetLightClientBootstrap(ctx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	res.CurrentSyncCommittee.Pubkeys = res.CurrentSyncCommittee.Pubkeys[:len(res.CurrentSyncCommittee.Pubkeys)-1]
+	return res, nil
+}
+
+func TestMakeUpdateFromLightClientAPIShortCommittee(t *testing.T) {
+	ctx := context.Background()
+	chain := newChainAt(t, testchain.Config{}, 29)
+	client := &shortCommitteeChain{newLightClientAPIChain(chain)}
+	c := &LightClient{Client: client, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true, UseLightClientAPI: true}
+
+	_, err := c.MakeUpdate(ctx, 0, 0)
+	require.ErrorIs(t, err, beaconclient.InvalidDataError)
+	assert.Contains(t, err.Error(), "expected 512 public keys, got 511")
+	require.Len(t, client.reports, 1)
+	assert.IsType(t, &beaconclient.ModelLightClientBootstrapData{}, client.reports[0])
+
+	// the next committee of a period update is checked in the same way
+	chain = newChainAt(t, testchain.Config{}, 84)
+	next := newLightClientAPIChain(chain)
+	next.corrupt = func(update *beaconclient.ModelLightClientUpdateData) {
+		if update.NextSyncCommittee != nil {
+			update.NextSyncCommittee.AggregatePubkey = update.NextSyncCommittee.AggregatePubkey[:47]
+		}
+	}
+	c = &LightClient{Client: next, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true, UseLightClientAPI: true}
+	_, err = c.MakeUpdate(ctx, 8, 0)
+	require.ErrorIs(t, err, beaconclient.InvalidDataError)
+	assert.Contains(t, err.Error(), "aggregate public key has invalid length 47")
+	require.Len(t, next.reports, 1)
+	assert.EqualValues(t, 31, next.reports[0].(*beaconclient.ModelLightClientUpdateData).SignatureSlot)
+}
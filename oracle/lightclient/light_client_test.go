@@ -0,0 +1,411 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/beaconclient"
+	"oracle/beaconserver"
+	"oracle/config"
+	"oracle/crypto"
+	"oracle/forks"
+	"oracle/testchain"
+)
+
+var testSpec = &config.SpecConfig{
+	SecondsPerSlot:                 12,
+	SlotsPerEpoch:                  32,
+	EpochsPerSyncCommitteePeriod:   256,
+	SyncCommitteeSize:              512,
+	ValidatorRegistryLimit:         1 << 40,
+	HistoricalRootsLimit:           1 << 24,
+	EpochsPerEth1VotingPeriod:      64,
+	SlotsPerHistoricalRoot:         8192,
+	PendingDepositsLimit:           1 << 27,
+	PendingPartialWithdrawalsLimit: 1 << 27,
+	PendingConsolidationsLimit:     1 << 18,
+}
+
+func bytesN(n int, seed byte) []byte {
+	res := make([]byte, n)
+	for i := range res {
+		res[i] = seed + byte(i)
+	}
+	return res
+}
+
+func rootsN(n int, seed byte) [][]byte {
+	res := make([][]byte, n)
+	for i := range res {
+		res[i] = bytesN(32, seed+byte(i))
+	}
+	return res
+}
+
+func setField(v reflect.Value, name string, value interface{}) {
+	if f := v.FieldByName(name); f.IsValid() {
+		f.Set(reflect.ValueOf(value))
+	}
+}
+
+// newTestState fills all fields of the given fork specific state with some non-empty values
+func newTestState(raw forks.Object) forks.Object {
+	v := reflect.ValueOf(raw).Elem()
+	committee := &forks.SyncCommittee{AggregatePubkey: bytesN(48, 1)}
+	for i := 0; i < 512; i++ {
+		committee.Pubkeys = append(committee.Pubkeys, bytesN(48, byte(i)))
+	}
+	checkpoint := &forks.Checkpoint{Epoch: 10, Root: bytesN(32, 3)}
+	validator := &forks.Validator{Pubkey: bytesN(48, 4), WithdrawalCredentials: bytesN(32, 5), EffectiveBalance: 32e9, ExitEpoch: 1 << 63}
+
+	setField(v, "GenesisTime", uint64(1606824023))
+	setField(v, "GenesisValidatorsRoot", bytesN(32, 1))
+	setField(v, "Slot", uint64(123456))
+	setField(v, "Fork", &forks.Fork{PreviousVersion: bytesN(4, 1), CurrentVersion: bytesN(4, 2), Epoch: 5})
+	setField(v, "LatestBlockHeader", &forks.BeaconBlockHeader{Slot: 1, ProposerIndex: 2, ParentRoot: bytesN(32, 1), StateRoot: bytesN(32, 2), BodyRoot: bytesN(32, 3)})
+	setField(v, "BlockRoots", rootsN(8192, 1))
+	setField(v, "StateRoots", rootsN(8192, 2))
+	setField(v, "HistoricalRoots", rootsN(3, 3))
+	setField(v, "Eth1Data", &forks.Eth1Data{DepositRoot: bytesN(32, 1), DepositCount: 4, BlockHash: bytesN(32, 2)})
+	setField(v, "Eth1DataVotes", []*forks.Eth1Data{{DepositRoot: bytesN(32, 3), BlockHash: bytesN(32, 4)}})
+	setField(v, "Eth1DepositIndex", uint64(7))
+	setField(v, "Validators", []*forks.Validator{validator, validator})
+	setField(v, "Balances", []uint64{1, 2, 3, 4, 5})
+	setField(v, "RandaoMixes", rootsN(65536, 4))
+	setField(v, "Slashings", make([]uint64, 8192))
+	setField(v, "PreviousEpochParticipation", []byte{1, 2, 3})
+	setField(v, "CurrentEpochParticipation", []byte{4, 5, 6})
+	setField(v, "JustificationBits", []byte{0x0f})
+	setField(v, "PreviousJustifiedCheckpoint", checkpoint)
+	setField(v, "CurrentJustifiedCheckpoint", checkpoint)
+	setField(v, "FinalizedCheckpoint", checkpoint)
+	setField(v, "InactivityScores", []uint64{7, 8})
+	setField(v, "CurrentSyncCommittee", committee)
+	setField(v, "NextSyncCommittee", committee)
+	setField(v, "NextWithdrawalIndex", uint64(11))
+	setField(v, "NextWithdrawalValidatorIndex", uint64(12))
+	setField(v, "HistoricalSummaries", []*forks.HistoricalSummary{{BlockSummaryRoot: bytesN(32, 1), StateSummaryRoot: bytesN(32, 2)}})
+	setField(v, "DepositRequestsStartIndex", uint64(13))
+	setField(v, "EarliestExitEpoch", uint64(14))
+	setField(v, "PendingDeposits", []*forks.PendingDeposit{{Pubkey: bytesN(48, 1), WithdrawalCredentials: bytesN(32, 2), Amount: 1, Signature: bytesN(96, 3), Slot: 4}})
+	setField(v, "PendingPartialWithdrawals", []*forks.PendingPartialWithdrawal{{ValidatorIndex: 1, Amount: 2}})
+	setField(v, "PendingConsolidations", []*forks.PendingConsolidation{{SourceIndex: 1, TargetIndex: 2}})
+	setField(v, "ProposerLookahead", make([]uint64, 64))
+
+	header := v.FieldByName("LatestExecutionPayloadHeader")
+	header.Set(reflect.New(header.Type().Elem()))
+	hv := header.Elem()
+	setField(hv, "ParentHash", bytesN(32, 1))
+	setField(hv, "FeeRecipient", bytesN(20, 2))
+	setField(hv, "StateRoot", bytesN(32, 3))
+	setField(hv, "ReceiptsRoot", bytesN(32, 4))
+	setField(hv, "LogsBloom", bytesN(256, 5))
+	setField(hv, "PrevRandao", bytesN(32, 6))
+	setField(hv, "BlockNumber", uint64(100))
+	setField(hv, "ExtraData", []byte("extra"))
+	setField(hv, "BaseFeePerGas", bytesN(32, 7))
+	setField(hv, "BlockHash", bytesN(32, 8))
+	setField(hv, "TransactionsRoot", bytesN(32, 9))
+	setField(hv, "WithdrawalsRoot", bytesN(32, 10))
+	setField(hv, "BlobGasUsed", uint64(11))
+	setField(hv, "ExcessBlobGas", uint64(12))
+	return raw
+}
+
+func TestMakeBeaconStateTree(t *testing.T) {
+	c := &LightClient{Spec: testSpec}
+	for version, raw := range map[forks.Version]forks.Object{
+		forks.Bellatrix: new(forks.BeaconStateBellatrix),
+		forks.Capella:   new(forks.BeaconStateCapella),
+		forks.Deneb:     new(forks.BeaconStateDeneb),
+		forks.Electra:   new(forks.BeaconStateElectra),
+		forks.Fulu:      new(forks.BeaconStateFulu),
+	} {
+		raw = newTestState(raw)
+		expected, err := raw.HashTreeRoot()
+		require.NoError(t, err)
+
+		data, err := raw.MarshalSSZ()
+		require.NoError(t, err)
+		state, err := forks.DecodeBeaconState(version, data)
+		require.NoError(t, err)
+
+		tree, err := c.makeBeaconStateTree(state)
+		require.NoError(t, err, version.String())
+		assert.Equal(t, common.Hash(expected), tree.Hash(), version.String())
+	}
+}
+
+func TestGetBeaconStateIncremental(t *testing.T) {
+	ctx := context.Background()
+	spec := testchain.DefaultSpec()
+	spec.CapellaForkVersion, spec.CapellaForkEpoch = "0x03000000", 2
+	chain, err := testchain.NewChain(testchain.Config{Spec: spec})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(24))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, StateMerkleizer: NewStateMerkleizer()}
+
+	// neighbouring slots, back and forth, and across the fork
+	for _, slot := range []uint64{10, 11, 12, 11, 15, 16, 17, 24} {
+		block, err := chain.GetBlock(ctx, strconv.FormatUint(slot, 10))
+		require.NoError(t, err)
+		_, tree, err := c.GetBeaconState(ctx, slot)
+		require.NoError(t, err)
+		assert.Equal(t, block.StateRoot, tree.Hash(), "slot %d", slot)
+	}
+	assert.Len(t, c.StateMerkleizer.merkleizers, 2)
+}
+
+func TestMakeUpdate(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{
+		Participation: func(slot uint64) int { return 400 },
+		Missed:        func(slot uint64) bool { return slot == 28 },
+	})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(29))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true}
+
+	update, err := c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	// slot 28 is missed, so the latest block at 29 signs the block at 27, which finalizes epoch 1
+	assert.EqualValues(t, 29, update.SignatureSlot)
+	assert.EqualValues(t, 27, update.AttestedHeader.Slot)
+	assert.EqualValues(t, 8, update.FinalizedHeader.Slot)
+	assert.Len(t, update.MissedSyncCommitteeParticipants, 512-400)
+
+	genesis, err := chain.GetBlock(ctx, "genesis")
+	require.NoError(t, err)
+	genesisState, err := chain.GetState(ctx, 0)
+	require.NoError(t, err)
+	committeeProof := crypto.NewMerkleProof(ContractGenIndices.CurrentSyncCommittee, update.SyncCommitteeBranch)
+	assert.Equal(t, genesis.StateRoot, committeeProof.ReconstructRoot(crypto.MustHashTreeRoot(genesisState.CurrentSyncCommittee)))
+
+	finalityProof := crypto.NewMerkleProof(ContractGenIndices.FinalizedRoot(), update.FinalityBranch)
+	assert.Equal(t, update.AttestedHeader.StateRoot, finalityProof.ReconstructRoot(update.FinalizedHeader.HashTreeRoot()))
+
+	// finalized header doesn't advance until the next epoch is finalized
+	update, err = c.MakeUpdate(ctx, 8, 0)
+	require.NoError(t, err)
+	assert.Nil(t, update)
+}
+
+func TestMakeUpdateAcrossPeriods(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{})
+	require.NoError(t, err)
+	slotsPerPeriod := chain.Spec.SlotsPerEpoch * chain.Spec.EpochsPerSyncCommitteePeriod
+	require.NoError(t, chain.AdvanceTo(4*slotsPerPeriod+10))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true}
+
+	// same loop as in the light client worker, each update is checked in the same way as BeaconLightClient.step does
+	head, err := chain.GetBlock(ctx, "genesis")
+	require.NoError(t, err)
+	var rotations int
+	for {
+		update, err := c.MakeUpdate(ctx, head.Slot, 0)
+		require.NoError(t, err)
+		if update == nil {
+			break
+		}
+		assert.Greater(t, update.FinalizedHeader.Slot, head.Slot)
+
+		headState, err := chain.GetState(ctx, head.Slot)
+		require.NoError(t, err)
+		index, cmt := ContractGenIndices.CurrentSyncCommittee, headState.CurrentSyncCommittee
+		switch update.SignatureSlot / slotsPerPeriod {
+		case head.Slot / slotsPerPeriod:
+		case head.Slot/slotsPerPeriod + 1:
+			index, cmt = ContractGenIndices.NextSyncCommittee, headState.NextSyncCommittee
+			rotations++
+		default:
+			require.Fail(t, "signature slot is too far in the future", "head %d, signature slot %d", head.Slot, update.SignatureSlot)
+		}
+		committeeProof := crypto.NewMerkleProof(index, update.SyncCommitteeBranch)
+		assert.Equal(t, head.StateRoot, committeeProof.ReconstructRoot(crypto.MustHashTreeRoot(cmt)), "head %d", head.Slot)
+		finalityProof := crypto.NewMerkleProof(ContractGenIndices.FinalizedRoot(), update.FinalityBranch)
+		assert.Equal(t, update.AttestedHeader.StateRoot, finalityProof.ReconstructRoot(update.FinalizedHeader.HashTreeRoot()))
+
+		head, err = chain.GetBlock(ctx, update.FinalizedHeader.HashTreeRoot().String())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 4, rotations)
+	finalized, err := chain.GetBlock(ctx, "finalized")
+	require.NoError(t, err)
+	assert.Equal(t, finalized.Root(), head.Root())
+}
+
+func TestMakeUpdateAcrossForks(t *testing.T) {
+	ctx := context.Background()
+	spec := testchain.DefaultSpec()
+	spec.CapellaForkVersion, spec.CapellaForkEpoch = "0x03000000", 5
+	chain, err := testchain.NewChain(testchain.Config{Spec: spec})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(60))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true}
+	head, err := chain.GetBlock(ctx, "8")
+	require.NoError(t, err)
+	state := &ContractState{
+		Head:          head.Slot,
+		HeadStateRoot: head.StateRoot,
+		Timestamp:     uint64(chain.Genesis.GenesisTime.Unix()) + 61*chain.Spec.SecondsPerSlot,
+	}
+
+	// capella is activated at slot 40, but the aggregate included in its first block is still signed by bellatrix fork
+	for signatureSlot, version := range map[uint64]string{
+		39: spec.BellatrixForkVersion,
+		40: spec.BellatrixForkVersion,
+		41: spec.CapellaForkVersion,
+		50: spec.CapellaForkVersion,
+	} {
+		update, err := c.MakeUpdate(ctx, head.Slot, signatureSlot)
+		require.NoError(t, err)
+		require.NotNil(t, update)
+		assert.Equal(t, signatureSlot, update.SignatureSlot)
+		assert.Equal(t, common.FromHex(version), update.ForkVersion[:], "signature slot %d", signatureSlot)
+		_, err = c.VerifyStep(state, update)
+		require.NoError(t, err, "signature slot %d", signatureSlot)
+	}
+
+	update, err := c.MakeUpdate(ctx, head.Slot, 40)
+	require.NoError(t, err)
+	copy(update.ForkVersion[:], common.FromHex(spec.CapellaForkVersion))
+	_, err = c.VerifyStep(state, update)
+	require.ErrorIs(t, err, InvalidSignatureError)
+	assert.Contains(t, err.Error(), "signature slot 40 belongs to fork version 02000000")
+}
+
+// corruptingClient replaces sync aggregate signatures of the blocks, for which signature returns a non-nil value
+type corruptingClient struct {
+	*testchain.Chain
+	signature func(slot uint64) []byte
+	reports   []uint64
+}
+
+func (c *corruptingClient) GetBlock(ctx context.Context, id string) (*forks.BeaconBlock, error) {
+	block, err := c.Chain.GetBlock(ctx, id)
+	if err != nil || block.SyncAggregate == nil {
+		return block, err
+	}
+	sig := c.signature(block.Slot)
+	if sig == nil {
+		return block, nil
+	}
+	res := *block
+	res.SyncAggregate = &forks.SyncAggregate{
+		SyncCommitteeBits:      block.SyncAggregate.SyncCommitteeBits,
+		SyncCommitteeSignature: sig,
+	}
+	return &res, nil
+}
+
+func (c *corruptingClient) ReportInvalidData(response interface{}) {
+	c.reports = append(c.reports, response.(*forks.BeaconBlock).Slot)
+}
+
+func TestMakeUpdateInvalidData(t *testing.T) {
+	chain, err := testchain.NewChain(testchain.Config{})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(29))
+	client := &corruptingClient{Chain: chain, signature: func(slot uint64) []byte {
+		return bytes.Repeat([]byte{0xff}, 96)
+	}}
+	c := &LightClient{Client: client, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true}
+
+	_, err = c.MakeUpdate(context.Background(), 0, 0)
+	assert.ErrorIs(t, err, beaconclient.InvalidDataError)
+	assert.Equal(t, []uint64{29}, client.reports)
+}
+
+// tamperingClient serves beacon states, that don't match the state roots of the blocks
+type tamperingClient struct {
+	*testchain.Chain
+}
+
+func (c *tamperingClient) GetState(ctx context.Context, slot uint64) (*forks.BeaconState, error) {
+	state, err := c.Chain.GetState(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	data, err := state.Raw.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	res, err := forks.DecodeBeaconState(state.Version, data)
+	if err != nil {
+		return nil, err
+	}
+	res.Raw.(*forks.BeaconStateBellatrix).Eth1DepositIndex++
+	return res, nil
+}
+
+func TestNewLightClientReportsInvalidData(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(29))
+	bad := httptest.NewServer(beaconserver.NewServer(&tamperingClient{Chain: chain}))
+	defer bad.Close()
+	good := httptest.NewServer(beaconserver.NewServer(chain))
+	defer good.Close()
+
+	// invalid data is reported through the cache to the beacon node, that served it, and the cached state is dropped
+	c, err := NewLightClient(ctx, config.Eth2Config{
+		Client: config.HTTPClientConfig{URL: bad.URL, URLs: []string{good.URL}},
+		Cache:  &config.CacheConfig{Dir: t.TempDir()},
+	}, true)
+	require.NoError(t, err)
+	require.IsType(t, &beaconclient.CachedClient{}, c.Client)
+
+	_, err = c.MakeUpdate(ctx, 0, 0)
+	require.ErrorIs(t, err, beaconclient.InvalidDataError)
+	update, err := c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.EqualValues(t, 28, update.AttestedHeader.Slot)
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(40))
+	srv := httptest.NewServer(beaconserver.NewServer(chain))
+	dir := t.TempDir()
+
+	type result struct {
+		update       *Update
+		sourceSlot   uint64
+		receiptsRoot []common.Hash
+	}
+	run := func(cfg config.HTTPClientConfig) *result {
+		c, err := NewLightClient(ctx, config.Eth2Config{Client: cfg}, true)
+		require.NoError(t, err)
+		res := new(result)
+		res.update, err = c.MakeUpdate(ctx, 0, 0)
+		require.NoError(t, err)
+		require.NotNil(t, res.update)
+		res.sourceSlot, err = c.FindBeaconBlockByExecutionBlockNumber(ctx, 20)
+		require.NoError(t, err)
+		res.receiptsRoot, err = c.MakeExecutionPayloadReceiptsRootProof(ctx, 40, res.sourceSlot)
+		require.NoError(t, err)
+		return res
+	}
+
+	recorded := run(config.HTTPClientConfig{URL: srv.URL, RecordDir: dir})
+	srv.Close()
+	assert.EqualValues(t, 20, recorded.sourceSlot)
+
+	// the same results are produced offline from the recorded fixtures
+	replayed := run(config.HTTPClientConfig{URL: "http://replay", ReplayDir: dir})
+	assert.Equal(t, recorded, replayed)
+}
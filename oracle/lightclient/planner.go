@@ -0,0 +1,170 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"oracle/beaconclient"
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+// UpdateStep is a single update of the catch-up plan, moving the light client head
+// from CurSlot to FinalizedSlot with a sync aggregate from the block at SignatureSlot
+type UpdateStep struct {
+	CurSlot       uint64
+	SignatureSlot uint64
+	FinalizedSlot uint64
+
+	// finality is proven while planning, so that MakeUpdates doesn't download the attested beacon state again
+	finality *finalityProof
+}
+
+// PeriodsBehind returns the number of sync committee periods between the light client head and the latest finalized block
+func (c *LightClient) PeriodsBehind(ctx context.Context, curSlot uint64) (uint64, error) {
+	finalized, err := c.Client.GetBlock(ctx, "finalized")
+	if err != nil {
+		return 0, fmt.Errorf("can't get finalized block: %w", err)
+	}
+	slotsPerPeriod := c.Spec.EpochsPerSyncCommitteePeriod * c.Spec.SlotsPerEpoch
+	if finalized.Slot/slotsPerPeriod < curSlot/slotsPerPeriod {
+		return 0, nil
+	}
+	return finalized.Slot/slotsPerPeriod - curSlot/slotsPerPeriod, nil
+}
+
+// PlanUpdates computes the shortest chain of finality updates from the light client head at curSlot to the latest finalized block.
+// Every step is signed by the latest suitable block of the next sync committee period, so each period is crossed with a single update.
+// With UpdateWindow, the best of the latest signature blocks is taken instead.
+// maxSteps limits the number of planned updates, 0 means no limit.
+// Sync aggregate signatures of all steps are batch verified, so that an invalid block doesn't fail the update generation halfway.
+func (c *LightClient) PlanUpdates(ctx context.Context, curSlot uint64, maxSteps int) ([]UpdateStep, error) {
+	if !c.WithFinality || c.UseLightClientAPI {
+		return nil, fmt.Errorf("update planning is only supported for finality updates built from beacon states")
+	}
+	finalized, err := c.Client.GetBlock(ctx, "finalized")
+	if err != nil {
+		return nil, fmt.Errorf("can't get finalized block: %w", err)
+	}
+	log.Printf("Planning updates from slot %d to finalized slot %d\n", curSlot, finalized.Slot)
+
+	slotsPerPeriod := c.Spec.EpochsPerSyncCommitteePeriod * c.Spec.SlotsPerEpoch
+	clockSlot := uint64(time.Since(c.Genesis.GenesisTime).Seconds()) / c.Spec.SecondsPerSlot
+	var plan []UpdateStep
+	var heads []*forks.BeaconBlock
+	var sets []crypto.SignatureSet
+	for curSlot < finalized.Slot && (maxSteps == 0 || len(plan) < maxSteps) {
+		slot := curSlot - curSlot%slotsPerPeriod + 2*slotsPerPeriod - 1
+		if clockSlot < slot {
+			slot = clockSlot
+		}
+		head, err := c.findBestSignatureBlock(ctx, slot, c.newLazyState(curSlot))
+		if err != nil {
+			return nil, err
+		}
+		if head == nil {
+			return nil, fmt.Errorf("no blocks with enough sync committee signatures in period %d", curSlot/slotsPerPeriod+1)
+		}
+		attestedBlock, err := c.Client.GetBlock(ctx, head.ParentRoot.String())
+		if err != nil {
+			return nil, fmt.Errorf("can't get block %s: %w", head.ParentRoot, err)
+		}
+		state, stateTree, err := c.GetBeaconState(ctx, attestedBlock.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("can't get beacon state %d: %w", attestedBlock.Slot, err)
+		}
+		finality, err := c.proveFinality(ctx, state, stateTree, ConvertToHeader(attestedBlock))
+		if err != nil {
+			return nil, err
+		}
+		if finality.header.Slot <= curSlot {
+			return nil, fmt.Errorf("block %d signed at slot %d doesn't finalize anything after slot %d", attestedBlock.Slot, head.Slot, curSlot)
+		}
+		cmt, err := c.syncCommitteeAt(state, head.Slot)
+		if err != nil {
+			return nil, err
+		}
+		set, err := c.signatureSet(cmt, head.SyncAggregate, head.ParentRoot, head.Slot, head)
+		if err != nil {
+			return nil, fmt.Errorf("can't check sync aggregate at slot %d: %w", head.Slot, err)
+		}
+		sets = append(sets, *set)
+		heads = append(heads, head)
+		log.Printf("Planned update from slot %d to slot %d, signed at slot %d\n", curSlot, finality.header.Slot, head.Slot)
+		plan = append(plan, UpdateStep{
+			CurSlot:       curSlot,
+			SignatureSlot: head.Slot,
+			FinalizedSlot: finality.header.Slot,
+			finality:      finality,
+		})
+		curSlot = finality.header.Slot
+	}
+
+	// all sync aggregates of the plan are verified at once, instead of a pairing per update
+	if invalid := crypto.BatchVerify(sets); len(invalid) > 0 {
+		var slots []uint64
+		for _, i := range invalid {
+			slots = append(slots, plan[i].SignatureSlot)
+			c.reportInvalidData(heads[i])
+		}
+		return nil, fmt.Errorf("%w: sync aggregates signed at slots %v have invalid signatures", beaconclient.InvalidDataError, slots)
+	}
+	return plan, nil
+}
+
+// MakeUpdates generates updates for the planned steps using up to parallelism concurrent workers.
+// Signature blocks were already chosen by PlanUpdates, so they are used as is, together with their finality proofs.
+// handle is called for every update in the plan order, generation stops on the first error.
+func (c *LightClient) MakeUpdates(ctx context.Context, plan []UpdateStep, parallelism int, handle func(step UpdateStep, update *Update) error) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		update *Update
+		err    error
+	}
+	results := make([]chan result, len(plan))
+	for i := range results {
+		results[i] = make(chan result, 1)
+	}
+	// slots are released only after the update is handled, so no more than parallelism updates are kept in memory
+	slots := make(chan struct{}, parallelism)
+	go func() {
+		for i, step := range plan {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(i int, step UpdateStep) {
+				update, err := c.makeUpdate(ctx, step.CurSlot, step.SignatureSlot, step.finality)
+				results[i] <- result{update: update, err: err}
+			}(i, step)
+		}
+	}()
+
+	for i, step := range plan {
+		var res result
+		select {
+		case res = <-results[i]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if res.err != nil {
+			return fmt.Errorf("can't make update from slot %d: %w", step.CurSlot, res.err)
+		}
+		if res.update == nil {
+			return fmt.Errorf("update from slot %d signed at slot %d is empty", step.CurSlot, step.SignatureSlot)
+		}
+		if err := handle(step, res.update); err != nil {
+			return err
+		}
+		<-slots
+	}
+	return nil
+}
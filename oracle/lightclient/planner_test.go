@@ -0,0 +1,136 @@
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/beaconclient"
+	"oracle/forks"
+	"oracle/testchain"
+)
+
+func TestPlanUpdates(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{})
+	require.NoError(t, err)
+	slotsPerPeriod := chain.Spec.SlotsPerEpoch * chain.Spec.EpochsPerSyncCommitteePeriod
+	require.NoError(t, chain.AdvanceTo(4*slotsPerPeriod+10))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true}
+
+	behind, err := c.PeriodsBehind(ctx, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, behind)
+
+	plan, err := c.PlanUpdates(ctx, 0, 0)
+	require.NoError(t, err)
+	// every update is signed at the end of the next period and finalizes the block two epochs before it
+	assert.Equal(t, []UpdateStep{
+		{CurSlot: 0, SignatureSlot: 63, FinalizedSlot: 40},
+		{CurSlot: 40, SignatureSlot: 95, FinalizedSlot: 72},
+		{CurSlot: 72, SignatureSlot: 127, FinalizedSlot: 104},
+		{CurSlot: 104, SignatureSlot: 138, FinalizedSlot: 120},
+	}, withoutProofs(plan))
+
+	// planned sync aggregates are batch verified, every invalid one is reported
+	block, err := chain.GetBlock(ctx, "62")
+	require.NoError(t, err)
+	client := &corruptingClient{Chain: chain, signature: func(slot uint64) []byte {
+		if slot == 63 || slot == 127 {
+			return block.SyncAggregate.SyncCommitteeSignature
+		}
+		return nil
+	}}
+	c.Client = client
+	_, err = c.PlanUpdates(ctx, 0, 0)
+	assert.ErrorIs(t, err, beaconclient.InvalidDataError)
+	assert.Contains(t, err.Error(), "[63 127]")
+	assert.Equal(t, []uint64{63, 127}, client.reports)
+	c.Client = chain
+
+	// with the window, equally signed blocks are ranked by is_better_update, which prefers older attested blocks
+	c.UpdateWindow = 3
+	windowed, err := c.PlanUpdates(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []UpdateStep{
+		{CurSlot: 0, SignatureSlot: 61, FinalizedSlot: 40},
+		{CurSlot: 40, SignatureSlot: 93, FinalizedSlot: 72},
+		{CurSlot: 72, SignatureSlot: 125, FinalizedSlot: 104},
+		{CurSlot: 104, SignatureSlot: 136, FinalizedSlot: 112},
+		{CurSlot: 112, SignatureSlot: 137, FinalizedSlot: 120},
+	}, withoutProofs(windowed))
+	err = c.MakeUpdates(ctx, windowed[:1], 1, func(step UpdateStep, update *Update) error {
+		assert.Equal(t, step.SignatureSlot, update.SignatureSlot)
+		return nil
+	})
+	require.NoError(t, err)
+	c.UpdateWindow = 0
+
+	limited, err := c.PlanUpdates(ctx, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, plan[:2], limited)
+
+	nothing, err := c.PlanUpdates(ctx, 120, 0)
+	require.NoError(t, err)
+	assert.Empty(t, nothing)
+
+	var handled []UpdateStep
+	err = c.MakeUpdates(ctx, plan, 3, func(step UpdateStep, update *Update) error {
+		handled = append(handled, step)
+		assert.Equal(t, step.SignatureSlot, update.SignatureSlot)
+		assert.Equal(t, step.FinalizedSlot, update.FinalizedHeader.Slot)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, plan, handled)
+
+	stop := errors.New("stop")
+	handled = nil
+	err = c.MakeUpdates(ctx, plan, 2, func(step UpdateStep, update *Update) error {
+		handled = append(handled, step)
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Len(t, handled, 1)
+
+	// attested beacon states are downloaded only once, while planning
+	counting := &stateCountingClient{Chain: chain, states: make(map[uint64]int)}
+	c.Client = counting
+	plan, err = c.PlanUpdates(ctx, 0, 0)
+	require.NoError(t, err)
+	err = c.MakeUpdates(ctx, plan, 2, func(step UpdateStep, update *Update) error {
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, counting.states, 2*len(plan))
+	for slot, n := range counting.states {
+		assert.Equal(t, 1, n, "state %d", slot)
+	}
+}
+
+// withoutProofs drops the finality proofs collected by the planner, so that steps can be compared by slots
+func withoutProofs(plan []UpdateStep) []UpdateStep {
+	res := make([]UpdateStep, len(plan))
+	for i, step := range plan {
+		res[i] = UpdateStep{CurSlot: step.CurSlot, SignatureSlot: step.SignatureSlot, FinalizedSlot: step.FinalizedSlot}
+	}
+	return res
+}
+
+// stateCountingClient counts downloaded beacon states by slot
+type stateCountingClient struct {
+	*testchain.Chain
+	mu     sync.Mutex
+	states map[uint64]int
+}
+
+func (c *stateCountingClient) GetState(ctx context.Context, slot uint64) (*forks.BeaconState, error) {
+	c.mu.Lock()
+	c.states[slot]++
+	c.mu.Unlock()
+	return c.Chain.GetState(ctx, slot)
+}
@@ -0,0 +1,82 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+// ProveStateField makes a merkle proof for the node at the given SSZ path of the beacon state at the given slot,
+// e.g. "latest_execution_payload_header.receipts_root" or "state_roots.5". It returns the proven node as well.
+func (c *LightClient) ProveStateField(ctx context.Context, slot uint64, path string) (*crypto.MerkleProof, common.Hash, error) {
+	state, stateTree, err := c.GetBeaconState(ctx, slot)
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("can't get beacon state: %w", err)
+	}
+	return c.proveStatePath(state, stateTree, path)
+}
+
+// ProveStateFields makes a merkle multiproof for the nodes at the given SSZ paths of the beacon state at the given slot
+func (c *LightClient) ProveStateFields(ctx context.Context, slot uint64, paths ...string) (*crypto.MerkleMultiProof, error) {
+	state, stateTree, err := c.GetBeaconState(ctx, slot)
+	if err != nil {
+		return nil, fmt.Errorf("can't get beacon state: %w", err)
+	}
+	return c.BeaconStateSchema(state.Version).ProveMultiFromTree(stateTree, state, parseSSZPaths(paths)...)
+}
+
+// ProveBlockField makes a merkle proof for the node at the given SSZ path of the beacon block, e.g. "body.execution_payload.block_hash",
+// the proof is rooted in the beacon block root
+func (c *LightClient) ProveBlockField(ctx context.Context, blockID string, path string) (*crypto.MerkleProof, common.Hash, error) {
+	block, message, err := c.getBlockMessage(ctx, blockID)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+	return c.BeaconBlockSchema(block.Version).Prove(message, crypto.ParseSSZPath(path)...)
+}
+
+// ProveBlockFields makes a merkle multiproof for the nodes at the given SSZ paths of the beacon block
+func (c *LightClient) ProveBlockFields(ctx context.Context, blockID string, paths ...string) (*crypto.MerkleMultiProof, error) {
+	block, message, err := c.getBlockMessage(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return c.BeaconBlockSchema(block.Version).ProveMulti(message, parseSSZPaths(paths)...)
+}
+
+// getBlockMessage returns the block together with the fork specific block message, checking that the schema reproduces its root
+func (c *LightClient) getBlockMessage(ctx context.Context, blockID string) (*forks.BeaconBlock, interface{}, error) {
+	block, err := c.Client.GetBlock(ctx, blockID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get block %s: %w", blockID, err)
+	}
+	if block.Signed == nil {
+		return nil, nil, fmt.Errorf("block %s has no %s message", blockID, block.Version)
+	}
+	message := reflect.ValueOf(block.Signed).Elem().FieldByName("Message").Interface()
+	root, err := c.BeaconBlockSchema(block.Version).HashTreeRoot(message)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't merkleize %s beacon block: %w", block.Version, err)
+	}
+	if root != block.Root() {
+		return nil, nil, fmt.Errorf("%s beacon block schema gives root %s instead of %s", block.Version, root, block.Root())
+	}
+	return block, message, nil
+}
+
+func (c *LightClient) proveStatePath(state *forks.BeaconState, stateTree *crypto.MerkleTree, path string) (*crypto.MerkleProof, common.Hash, error) {
+	return c.BeaconStateSchema(state.Version).ProveFromTree(stateTree, state, crypto.ParseSSZPath(path)...)
+}
+
+func parseSSZPaths(paths []string) [][]interface{} {
+	var res [][]interface{}
+	for _, path := range paths {
+		res = append(res, crypto.ParseSSZPath(path))
+	}
+	return res
+}
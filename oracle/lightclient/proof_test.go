@@ -0,0 +1,93 @@
+package lightclient
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/crypto"
+	"oracle/testchain"
+)
+
+func TestProveStateAndBlockFields(t *testing.T) {
+	ctx := context.Background()
+	spec := testchain.DefaultSpec()
+	spec.CapellaForkVersion, spec.CapellaForkEpoch = "0x03000000", 2
+	chain, err := testchain.NewChain(testchain.Config{Spec: spec})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(20))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis}
+
+	// bellatrix and capella states
+	for _, slot := range []uint64{10, 20} {
+		block, err := chain.GetBlock(ctx, strconv.FormatUint(slot, 10))
+		require.NoError(t, err)
+		gi, err := c.GenIndicesAt(slot)
+		require.NoError(t, err)
+		state, err := chain.GetState(ctx, slot)
+		require.NoError(t, err)
+
+		proof, leaf, err := c.ProveStateField(ctx, slot, "latest_execution_payload_header.receipts_root")
+		require.NoError(t, err)
+		assert.Equal(t, common.BytesToHash(state.LatestExecutionPayloadHeader.ReceiptsRoot), leaf)
+		assert.Equal(t, crypto.ConcatGenIndices(gi.LatestExecutionPayloadHeader, gi.PayloadReceiptsRoot), proof.GenIndex())
+		assert.Equal(t, block.StateRoot, proof.ReconstructRoot(leaf))
+
+		multiProof, err := c.ProveStateFields(ctx, slot, "finalized_checkpoint.root", "state_roots.5", "next_sync_committee")
+		require.NoError(t, err)
+		assert.Equal(t, []common.Hash{
+			common.BytesToHash(state.FinalizedCheckpoint.Root),
+			common.BytesToHash(state.StateRoots[5]),
+			crypto.MustHashTreeRoot(state.NextSyncCommittee),
+		}, multiProof.Leaves())
+		assert.Equal(t, block.StateRoot, multiProof.ReconstructRoot())
+
+		_, err = c.ProveStateFields(ctx, slot, "finalized_checkpoint", "finalized_checkpoint.root")
+		assert.Error(t, err)
+
+		proof, leaf, err = c.ProveBlockField(ctx, block.Root().String(), "body.execution_payload.block_hash")
+		require.NoError(t, err)
+		assert.Equal(t, block.ExecutionPayload.BlockHash, leaf.Bytes())
+		assert.Equal(t, block.Root(), proof.ReconstructRoot(leaf))
+
+		multiProof, err = c.ProveBlockFields(ctx, block.Root().String(), "state_root", "body.sync_aggregate")
+		require.NoError(t, err)
+		assert.Equal(t, []common.Hash{block.StateRoot, crypto.MustHashTreeRoot(block.SyncAggregate)}, multiProof.Leaves())
+		assert.Equal(t, block.Root(), multiProof.ReconstructRoot())
+	}
+
+	_, _, err = c.ProveStateField(ctx, 20, "latest_execution_payload_header.unknown")
+	assert.Error(t, err)
+}
+
+func TestMakeExecutionPayloadReceiptsRootProof(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(40))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis}
+
+	source, err := chain.GetBlock(ctx, "40")
+	require.NoError(t, err)
+	for _, targetSlot := range []uint64{40, 35, 1} {
+		target, err := chain.GetState(ctx, targetSlot)
+		require.NoError(t, err)
+		path, err := c.MakeExecutionPayloadReceiptsRootProof(ctx, 40, targetSlot)
+		require.NoError(t, err, "target slot %d", targetSlot)
+
+		// the same generalized indices are used by TrustlessAMB.sol
+		genIndex := crypto.ConcatGenIndices(ContractGenIndices.LatestExecutionPayloadHeader, ContractGenIndices.PayloadReceiptsRoot)
+		if targetSlot != 40 {
+			genIndex = crypto.ConcatGenIndices(ContractGenIndices.StateRoots, int(c.Spec.SlotsPerHistoricalRoot+targetSlot), genIndex)
+		}
+		proof := crypto.NewMerkleProof(genIndex, path)
+		assert.Equal(t, source.StateRoot, proof.ReconstructRoot(common.BytesToHash(target.LatestExecutionPayloadHeader.ReceiptsRoot)), "target slot %d", targetSlot)
+	}
+
+	_, err = c.MakeExecutionPayloadReceiptsRootProof(ctx, 35, 40)
+	assert.Error(t, err)
+}
@@ -0,0 +1,330 @@
+package lightclient
+
+import (
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+// vector lengths of the mainnet preset, that are not part of the spec config, they are also fixed by the forks package
+const (
+	epochsPerHistoricalVector = 65536
+	epochsPerSlashingsVector  = 8192
+	// (MIN_SEED_LOOKAHEAD + 1) * SLOTS_PER_EPOCH
+	proposerLookaheadLength = 64
+	justificationBitsLength = 4
+	bytesPerLogsBloom       = 256
+	maxExtraDataBytes       = 32
+)
+
+var (
+	forkSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("PreviousVersion", crypto.NewSSZByteVector(4)),
+		crypto.NewSSZField("CurrentVersion", crypto.NewSSZByteVector(4)),
+		crypto.NewSSZField("Epoch", crypto.SSZUint64),
+	)
+	checkpointSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Epoch", crypto.SSZUint64),
+		crypto.NewSSZField("Root", crypto.SSZBytes32),
+	)
+	beaconBlockHeaderSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Slot", crypto.SSZUint64),
+		crypto.NewSSZField("ProposerIndex", crypto.SSZUint64),
+		crypto.NewSSZField("ParentRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("StateRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("BodyRoot", crypto.SSZBytes32),
+	)
+	eth1DataSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("DepositRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("DepositCount", crypto.SSZUint64),
+		crypto.NewSSZField("BlockHash", crypto.SSZBytes32),
+	)
+	validatorSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Pubkey", crypto.NewSSZByteVector(48)),
+		crypto.NewSSZField("WithdrawalCredentials", crypto.SSZBytes32),
+		crypto.NewSSZField("EffectiveBalance", crypto.SSZUint64),
+		crypto.NewSSZField("Slashed", crypto.SSZBool),
+		crypto.NewSSZField("ActivationEligibilityEpoch", crypto.SSZUint64),
+		crypto.NewSSZField("ActivationEpoch", crypto.SSZUint64),
+		crypto.NewSSZField("ExitEpoch", crypto.SSZUint64),
+		crypto.NewSSZField("WithdrawableEpoch", crypto.SSZUint64),
+	)
+	historicalSummarySchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("BlockSummaryRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("StateSummaryRoot", crypto.SSZBytes32),
+	)
+	pendingDepositSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Pubkey", crypto.NewSSZByteVector(48)),
+		crypto.NewSSZField("WithdrawalCredentials", crypto.SSZBytes32),
+		crypto.NewSSZField("Amount", crypto.SSZUint64),
+		crypto.NewSSZField("Signature", crypto.NewSSZByteVector(96)),
+		crypto.NewSSZField("Slot", crypto.SSZUint64),
+	)
+	pendingPartialWithdrawalSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("ValidatorIndex", crypto.SSZUint64),
+		crypto.NewSSZField("Amount", crypto.SSZUint64),
+		crypto.NewSSZField("WithdrawableEpoch", crypto.SSZUint64),
+	)
+	pendingConsolidationSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("SourceIndex", crypto.SSZUint64),
+		crypto.NewSSZField("TargetIndex", crypto.SSZUint64),
+	)
+)
+
+// ExecutionPayloadHeaderSchema returns the SSZ schema of the execution payload header at the given fork
+func ExecutionPayloadHeaderSchema(version forks.Version) *crypto.SSZType {
+	fields := []crypto.SSZField{
+		crypto.NewSSZField("ParentHash", crypto.SSZBytes32),
+		crypto.NewSSZField("FeeRecipient", crypto.NewSSZByteVector(20)),
+		crypto.NewSSZField("StateRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("ReceiptsRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("LogsBloom", crypto.NewSSZByteVector(bytesPerLogsBloom)),
+		crypto.NewSSZField("PrevRandao", crypto.SSZBytes32),
+		crypto.NewSSZField("BlockNumber", crypto.SSZUint64),
+		crypto.NewSSZField("GasLimit", crypto.SSZUint64),
+		crypto.NewSSZField("GasUsed", crypto.SSZUint64),
+		crypto.NewSSZField("Timestamp", crypto.SSZUint64),
+		crypto.NewSSZField("ExtraData", crypto.NewSSZByteList(maxExtraDataBytes)),
+		crypto.NewSSZField("BaseFeePerGas", crypto.SSZUint256),
+		crypto.NewSSZField("BlockHash", crypto.SSZBytes32),
+		crypto.NewSSZField("TransactionsRoot", crypto.SSZBytes32),
+	}
+	if version >= forks.Capella {
+		fields = append(fields, crypto.NewSSZField("WithdrawalsRoot", crypto.SSZBytes32))
+	}
+	if version >= forks.Deneb {
+		fields = append(fields,
+			crypto.NewSSZField("BlobGasUsed", crypto.SSZUint64),
+			crypto.NewSSZField("ExcessBlobGas", crypto.SSZUint64),
+		)
+	}
+	return crypto.NewSSZContainer(fields...)
+}
+
+// BeaconStateSchema returns the SSZ schema of the beacon state at the given post-merge fork, list limits are taken from the spec
+func (c *LightClient) BeaconStateSchema(version forks.Version) *crypto.SSZType {
+	validatorsLimit := c.Spec.ValidatorRegistryLimit
+	syncCommittee := crypto.NewSSZContainer(
+		crypto.NewSSZField("Pubkeys", crypto.NewSSZVector(crypto.NewSSZByteVector(48), c.Spec.SyncCommitteeSize)),
+		crypto.NewSSZField("AggregatePubkey", crypto.NewSSZByteVector(48)),
+	)
+	fields := []crypto.SSZField{
+		crypto.NewSSZField("GenesisTime", crypto.SSZUint64),
+		crypto.NewSSZField("GenesisValidatorsRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("Slot", crypto.SSZUint64),
+		crypto.NewSSZField("Fork", forkSchema),
+		crypto.NewSSZField("LatestBlockHeader", beaconBlockHeaderSchema),
+		crypto.NewSSZField("BlockRoots", crypto.NewSSZVector(crypto.SSZBytes32, int(c.Spec.SlotsPerHistoricalRoot))),
+		crypto.NewSSZField("StateRoots", crypto.NewSSZVector(crypto.SSZBytes32, int(c.Spec.SlotsPerHistoricalRoot))),
+		crypto.NewSSZField("HistoricalRoots", crypto.NewSSZList(crypto.SSZBytes32, c.Spec.HistoricalRootsLimit)),
+		crypto.NewSSZField("Eth1Data", eth1DataSchema),
+		crypto.NewSSZField("Eth1DataVotes", crypto.NewSSZList(eth1DataSchema, int(c.Spec.SlotsPerEpoch*c.Spec.EpochsPerEth1VotingPeriod))),
+		crypto.NewSSZField("Eth1DepositIndex", crypto.SSZUint64),
+		crypto.NewSSZField("Validators", crypto.NewSSZList(validatorSchema, validatorsLimit)),
+		crypto.NewSSZField("Balances", crypto.NewSSZList(crypto.SSZUint64, validatorsLimit)),
+		crypto.NewSSZField("RandaoMixes", crypto.NewSSZVector(crypto.SSZBytes32, epochsPerHistoricalVector)),
+		crypto.NewSSZField("Slashings", crypto.NewSSZVector(crypto.SSZUint64, epochsPerSlashingsVector)),
+		crypto.NewSSZField("PreviousEpochParticipation", crypto.NewSSZList(crypto.SSZUint8, validatorsLimit)),
+		crypto.NewSSZField("CurrentEpochParticipation", crypto.NewSSZList(crypto.SSZUint8, validatorsLimit)),
+		crypto.NewSSZField("JustificationBits", crypto.NewSSZBitvector(justificationBitsLength)),
+		crypto.NewSSZField("PreviousJustifiedCheckpoint", checkpointSchema),
+		crypto.NewSSZField("CurrentJustifiedCheckpoint", checkpointSchema),
+		crypto.NewSSZField("FinalizedCheckpoint", checkpointSchema),
+		crypto.NewSSZField("InactivityScores", crypto.NewSSZList(crypto.SSZUint64, validatorsLimit)),
+		crypto.NewSSZField("CurrentSyncCommittee", syncCommittee),
+		crypto.NewSSZField("NextSyncCommittee", syncCommittee),
+		crypto.NewSSZField("LatestExecutionPayloadHeader", ExecutionPayloadHeaderSchema(version)),
+	}
+	if version >= forks.Capella {
+		fields = append(fields,
+			crypto.NewSSZField("NextWithdrawalIndex", crypto.SSZUint64),
+			crypto.NewSSZField("NextWithdrawalValidatorIndex", crypto.SSZUint64),
+			crypto.NewSSZField("HistoricalSummaries", crypto.NewSSZList(historicalSummarySchema, c.Spec.HistoricalRootsLimit)),
+		)
+	}
+	if version >= forks.Electra {
+		fields = append(fields,
+			crypto.NewSSZField("DepositRequestsStartIndex", crypto.SSZUint64),
+			crypto.NewSSZField("DepositBalanceToConsume", crypto.SSZUint64),
+			crypto.NewSSZField("ExitBalanceToConsume", crypto.SSZUint64),
+			crypto.NewSSZField("EarliestExitEpoch", crypto.SSZUint64),
+			crypto.NewSSZField("ConsolidationBalanceToConsume", crypto.SSZUint64),
+			crypto.NewSSZField("EarliestConsolidationEpoch", crypto.SSZUint64),
+			crypto.NewSSZField("PendingDeposits", crypto.NewSSZList(pendingDepositSchema, c.Spec.PendingDepositsLimit)),
+			crypto.NewSSZField("PendingPartialWithdrawals", crypto.NewSSZList(pendingPartialWithdrawalSchema, c.Spec.PendingPartialWithdrawalsLimit)),
+			crypto.NewSSZField("PendingConsolidations", crypto.NewSSZList(pendingConsolidationSchema, c.Spec.PendingConsolidationsLimit)),
+		)
+	}
+	if version >= forks.Fulu {
+		fields = append(fields, crypto.NewSSZField("ProposerLookahead", crypto.NewSSZVector(crypto.SSZUint64, proposerLookaheadLength)))
+	}
+	return crypto.NewSSZContainer(fields...)
+}
+
+// block body list limits of the mainnet preset, also fixed by the forks package
+const (
+	maxProposerSlashings          = 16
+	maxAttesterSlashings          = 2
+	maxAttesterSlashingsElectra   = 1
+	maxAttestations               = 128
+	maxAttestationsElectra        = 8
+	maxValidatorsPerCommittee     = 2048
+	maxCommitteesPerSlot          = 64
+	maxDeposits                   = 16
+	maxVoluntaryExits             = 16
+	maxBLSToExecutionChanges      = 16
+	maxBlobCommitmentsPerBlock    = 4096
+	maxTransactionsPerPayload     = 1 << 20
+	maxBytesPerTransaction        = 1 << 30
+	maxWithdrawalsPerPayload      = 16
+	maxDepositRequestsPerPayload  = 8192
+	maxWithdrawalRequestsPerBlock = 16
+	maxConsolidationRequests      = 2
+	depositContractTreeDepth      = 32
+)
+
+var (
+	signedBeaconBlockHeaderSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Message", beaconBlockHeaderSchema),
+		crypto.NewSSZField("Signature", crypto.NewSSZByteVector(96)),
+	)
+	proposerSlashingSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Header1", signedBeaconBlockHeaderSchema),
+		crypto.NewSSZField("Header2", signedBeaconBlockHeaderSchema),
+	)
+	attestationDataSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Slot", crypto.SSZUint64),
+		crypto.NewSSZField("Index", crypto.SSZUint64),
+		crypto.NewSSZField("BeaconBlockRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("Source", checkpointSchema),
+		crypto.NewSSZField("Target", checkpointSchema),
+	)
+	depositSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Proof", crypto.NewSSZVector(crypto.SSZBytes32, depositContractTreeDepth+1)),
+		crypto.NewSSZField("Data", crypto.NewSSZContainer(
+			crypto.NewSSZField("Pubkey", crypto.NewSSZByteVector(48)),
+			crypto.NewSSZField("WithdrawalCredentials", crypto.SSZBytes32),
+			crypto.NewSSZField("Amount", crypto.SSZUint64),
+			crypto.NewSSZField("Signature", crypto.NewSSZByteVector(96)),
+		)),
+	)
+	signedVoluntaryExitSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Message", crypto.NewSSZContainer(
+			crypto.NewSSZField("Epoch", crypto.SSZUint64),
+			crypto.NewSSZField("ValidatorIndex", crypto.SSZUint64),
+		)),
+		crypto.NewSSZField("Signature", crypto.NewSSZByteVector(96)),
+	)
+	withdrawalSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Index", crypto.SSZUint64),
+		crypto.NewSSZField("ValidatorIndex", crypto.SSZUint64),
+		crypto.NewSSZField("Address", crypto.NewSSZByteVector(20)),
+		crypto.NewSSZField("Amount", crypto.SSZUint64),
+	)
+	signedBLSToExecutionChangeSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Message", crypto.NewSSZContainer(
+			crypto.NewSSZField("ValidatorIndex", crypto.SSZUint64),
+			crypto.NewSSZField("FromBLSPubkey", crypto.NewSSZByteVector(48)),
+			crypto.NewSSZField("ToExecutionAddress", crypto.NewSSZByteVector(20)),
+		)),
+		crypto.NewSSZField("Signature", crypto.NewSSZByteVector(96)),
+	)
+	executionRequestsSchema = crypto.NewSSZContainer(
+		crypto.NewSSZField("Deposits", crypto.NewSSZList(crypto.NewSSZContainer(
+			crypto.NewSSZField("Pubkey", crypto.NewSSZByteVector(48)),
+			crypto.NewSSZField("WithdrawalCredentials", crypto.SSZBytes32),
+			crypto.NewSSZField("Amount", crypto.SSZUint64),
+			crypto.NewSSZField("Signature", crypto.NewSSZByteVector(96)),
+			crypto.NewSSZField("Index", crypto.SSZUint64),
+		), maxDepositRequestsPerPayload)),
+		crypto.NewSSZField("Withdrawals", crypto.NewSSZList(crypto.NewSSZContainer(
+			crypto.NewSSZField("SourceAddress", crypto.NewSSZByteVector(20)),
+			crypto.NewSSZField("ValidatorPubkey", crypto.NewSSZByteVector(48)),
+			crypto.NewSSZField("Amount", crypto.SSZUint64),
+		), maxWithdrawalRequestsPerBlock)),
+		crypto.NewSSZField("Consolidations", crypto.NewSSZList(crypto.NewSSZContainer(
+			crypto.NewSSZField("SourceAddress", crypto.NewSSZByteVector(20)),
+			crypto.NewSSZField("SourcePubkey", crypto.NewSSZByteVector(48)),
+			crypto.NewSSZField("TargetPubkey", crypto.NewSSZByteVector(48)),
+		), maxConsolidationRequests)),
+	)
+)
+
+func indexedAttestationSchema(maxIndices int) *crypto.SSZType {
+	return crypto.NewSSZContainer(
+		crypto.NewSSZField("AttestingIndices", crypto.NewSSZList(crypto.SSZUint64, maxIndices)),
+		crypto.NewSSZField("Data", attestationDataSchema),
+		crypto.NewSSZField("Signature", crypto.NewSSZByteVector(96)),
+	)
+}
+
+// ExecutionPayloadSchema returns the SSZ schema of the execution payload at the given fork
+func ExecutionPayloadSchema(version forks.Version) *crypto.SSZType {
+	header := ExecutionPayloadHeaderSchema(version)
+	fields := append([]crypto.SSZField{}, header.Fields[:13]...)
+	fields = append(fields, crypto.NewSSZField("Transactions", crypto.NewSSZList(crypto.NewSSZByteList(maxBytesPerTransaction), maxTransactionsPerPayload)))
+	if version >= forks.Capella {
+		fields = append(fields, crypto.NewSSZField("Withdrawals", crypto.NewSSZList(withdrawalSchema, maxWithdrawalsPerPayload)))
+	}
+	// blob gas fields follow the withdrawals_root in the header
+	return crypto.NewSSZContainer(append(fields, header.Fields[len(fields):]...)...)
+}
+
+// BeaconBlockSchema returns the SSZ schema of the beacon block message at the given post-merge fork
+func (c *LightClient) BeaconBlockSchema(version forks.Version) *crypto.SSZType {
+	attesterSlashings, attestations := maxAttesterSlashings, maxAttestations
+	indexed := indexedAttestationSchema(maxValidatorsPerCommittee)
+	attestationFields := []crypto.SSZField{
+		crypto.NewSSZField("AggregationBits", crypto.NewSSZBitlist(maxValidatorsPerCommittee)),
+		crypto.NewSSZField("Data", attestationDataSchema),
+		crypto.NewSSZField("Signature", crypto.NewSSZByteVector(96)),
+	}
+	if version >= forks.Electra {
+		attesterSlashings, attestations = maxAttesterSlashingsElectra, maxAttestationsElectra
+		indexed = indexedAttestationSchema(maxValidatorsPerCommittee * maxCommitteesPerSlot)
+		attestationFields[0] = crypto.NewSSZField("AggregationBits", crypto.NewSSZBitlist(maxValidatorsPerCommittee*maxCommitteesPerSlot))
+		attestationFields = append(attestationFields, crypto.NewSSZField("CommitteeBits", crypto.NewSSZBitvector(maxCommitteesPerSlot)))
+	}
+	attesterSlashing := crypto.NewSSZContainer(
+		crypto.NewSSZField("Attestation1", indexed),
+		crypto.NewSSZField("Attestation2", indexed),
+	)
+	body := []crypto.SSZField{
+		crypto.NewSSZField("RandaoReveal", crypto.NewSSZByteVector(96)),
+		crypto.NewSSZField("Eth1Data", eth1DataSchema),
+		crypto.NewSSZField("Graffiti", crypto.SSZBytes32),
+		crypto.NewSSZField("ProposerSlashings", crypto.NewSSZList(proposerSlashingSchema, maxProposerSlashings)),
+		crypto.NewSSZField("AttesterSlashings", crypto.NewSSZList(attesterSlashing, attesterSlashings)),
+		crypto.NewSSZField("Attestations", crypto.NewSSZList(crypto.NewSSZContainer(attestationFields...), attestations)),
+		crypto.NewSSZField("Deposits", crypto.NewSSZList(depositSchema, maxDeposits)),
+		crypto.NewSSZField("VoluntaryExits", crypto.NewSSZList(signedVoluntaryExitSchema, maxVoluntaryExits)),
+		crypto.NewSSZField("SyncAggregate", crypto.NewSSZContainer(
+			crypto.NewSSZField("SyncCommitteeBits", crypto.NewSSZBitvector(c.Spec.SyncCommitteeSize)),
+			crypto.NewSSZField("SyncCommitteeSignature", crypto.NewSSZByteVector(96)),
+		)),
+		crypto.NewSSZField("ExecutionPayload", ExecutionPayloadSchema(version)),
+	}
+	if version >= forks.Capella {
+		body = append(body, crypto.NewSSZField("BLSToExecutionChanges", crypto.NewSSZList(signedBLSToExecutionChangeSchema, maxBLSToExecutionChanges)))
+	}
+	if version >= forks.Deneb {
+		body = append(body, crypto.NewSSZField("BlobKZGCommitments", crypto.NewSSZList(crypto.NewSSZByteVector(48), maxBlobCommitmentsPerBlock)))
+	}
+	if version >= forks.Electra {
+		body = append(body, crypto.NewSSZField("ExecutionRequests", executionRequestsSchema))
+	}
+	return crypto.NewSSZContainer(
+		crypto.NewSSZField("Slot", crypto.SSZUint64),
+		crypto.NewSSZField("ProposerIndex", crypto.SSZUint64),
+		crypto.NewSSZField("ParentRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("StateRoot", crypto.SSZBytes32),
+		crypto.NewSSZField("Body", crypto.NewSSZContainer(body...)),
+	)
+}
+
+// historicalBatchSchema matches both HistoricalBatch and HistoricalSummary roots, the latter holds roots of the same vectors
+func (c *LightClient) historicalBatchSchema() *crypto.SSZType {
+	return crypto.NewSSZContainer(
+		crypto.NewSSZField("BlockRoots", crypto.NewSSZVector(crypto.SSZBytes32, int(c.Spec.SlotsPerHistoricalRoot))),
+		crypto.NewSSZField("StateRoots", crypto.NewSSZVector(crypto.SSZBytes32, int(c.Spec.SlotsPerHistoricalRoot))),
+	)
+}
@@ -1,10 +1,13 @@
 package lightclient
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
-	ethpb2 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 
+	"oracle/beaconclient"
 	"oracle/crypto"
+	"oracle/forks"
 )
 
 type BeaconBlockHeader struct {
@@ -15,6 +18,16 @@ type BeaconBlockHeader struct {
 	BodyRoot      common.Hash `json:"bodyRoot" abi:"bodyRoot"`
 }
 
+func (h *BeaconBlockHeader) HashTreeRoot() common.Hash {
+	return crypto.NewVectorMerkleTree(
+		crypto.UintToHash(h.Slot),
+		crypto.UintToHash(h.ProposerIndex),
+		h.ParentRoot,
+		h.StateRoot,
+		h.BodyRoot,
+	).Hash()
+}
+
 type Update struct {
 	ForkVersion                     [4]byte                    `json:"forkVersion" abi:"forkVersion"`
 	SignatureSlot                   uint64                     `json:"signatureSlot" abi:"signatureSlot"`
@@ -34,23 +47,58 @@ type SyncCommittee struct {
 	AggregateKey crypto.G1Point
 }
 
-func ConvertToSyncCommittee(cm *ethpb2.SyncCommittee) *SyncCommittee {
+// ConvertToSyncCommittee decodes the public keys of the sync committee, failing on invalid curve points
+func ConvertToSyncCommittee(cm *forks.SyncCommittee) (*SyncCommittee, error) {
+	aggregateKey, err := crypto.DecodePK(cm.AggregatePubkey)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate public key: %w", err)
+	}
 	committee := &SyncCommittee{
 		PublicKeys:   make([]crypto.G1PointCompressed, len(cm.Pubkeys)),
-		AggregateKey: crypto.MustDecodePK(cm.AggregatePubkey),
+		AggregateKey: aggregateKey,
 	}
 	for i, pk := range cm.Pubkeys {
-		committee.PublicKeys[i] = crypto.MustDecodePKCompressed(pk)
+		if committee.PublicKeys[i], err = crypto.DecodePKCompressed(pk); err != nil {
+			return nil, fmt.Errorf("public key %d: %w", i, err)
+		}
+	}
+	return committee, nil
+}
+
+func ConvertToHeader(block *forks.BeaconBlock) BeaconBlockHeader {
+	return BeaconBlockHeader{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		StateRoot:     block.StateRoot,
+		BodyRoot:      block.BodyRoot,
 	}
-	return committee
 }
 
-func ConvertToHeader(block *ethpb2.BeaconBlockBellatrix) BeaconBlockHeader {
+func ConvertModelToHeader(header *beaconclient.ModelLightClientHeader) BeaconBlockHeader {
 	return BeaconBlockHeader{
-		Slot:          uint64(block.Slot),
-		ProposerIndex: uint64(block.ProposerIndex),
-		ParentRoot:    common.BytesToHash(block.ParentRoot),
-		StateRoot:     common.BytesToHash(block.StateRoot),
-		BodyRoot:      crypto.MustHashTreeRoot(block.Body),
+		Slot:          header.Beacon.Slot,
+		ProposerIndex: header.Beacon.ProposerIndex,
+		ParentRoot:    header.Beacon.ParentRoot,
+		StateRoot:     header.Beacon.StateRoot,
+		BodyRoot:      header.Beacon.BodyRoot,
+	}
+}
+
+func ConvertModelToSyncCommittee(cm *beaconclient.ModelSyncCommittee) *forks.SyncCommittee {
+	pubkeys := make([][]byte, len(cm.Pubkeys))
+	for i, pk := range cm.Pubkeys {
+		pubkeys[i] = pk
+	}
+	return &forks.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: cm.AggregatePubkey,
+	}
+}
+
+func ConvertModelToSyncAggregate(agg *beaconclient.ModelSyncAggregate) *forks.SyncAggregate {
+	return &forks.SyncAggregate{
+		SyncCommitteeBits:      agg.SyncCommitteeBits,
+		SyncCommitteeSignature: agg.SyncCommitteeSignature,
 	}
 }
@@ -0,0 +1,280 @@
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"oracle/crypto"
+)
+
+// reasons of BeaconLightClient.step failures, the messages are the same as contract revert reasons
+var (
+	InvalidFinalizedHeaderError = errors.New("Invalid finalizedHeader")
+	OldUpdateError              = errors.New("Update slot is less or equal than current head")
+	FutureUpdateError           = errors.New("Update slot is too far in the future")
+	FutureSignatureError        = errors.New("Signature slot is too far in the future")
+	InvalidProofLengthError     = errors.New("invalid proof length")
+	FinalityProofError          = errors.New("Cannot verify finality checkpoint proof")
+	NotEnoughSignaturesError    = errors.New("Not enough signatures")
+	MissedParticipantsError     = errors.New("Invalid number of missed sync committee members")
+	MultiProofError             = errors.New("Arrays lengths mismatch")
+	SyncCommitteeProofError     = errors.New("Cannot verify sync committee proof")
+	InvalidSignatureError       = errors.New("Invalid signature")
+	NotBestCandidateError       = errors.New("Not a best candidate update")
+	InvalidPointError           = errors.New("invalid curve point")
+	ContractPanicError          = errors.New("contract panic")
+)
+
+// StepError is returned by VerifyStep for updates, that would be reverted by the contract.
+// It wraps one of the typed errors above, so that the failed check can be matched with errors.Is.
+type StepError struct {
+	Reason  error
+	Details string
+}
+
+func (e *StepError) Error() string {
+	if e.Details == "" {
+		return e.Reason.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.Reason, e.Details)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Reason
+}
+
+func stepError(reason error, format string, args ...interface{}) *StepError {
+	return &StepError{Reason: reason, Details: fmt.Sprintf(format, args...)}
+}
+
+// ContractState is the part of BeaconLightClient storage and environment, that affects the step execution
+type ContractState struct {
+	Head            uint64
+	HeadStateRoot   common.Hash
+	BestValidUpdate BestValidUpdate
+	// Timestamp of the block executing the update
+	Timestamp uint64
+}
+
+// StepResult describes the effect of the successful step execution
+type StepResult struct {
+	ActiveSlot uint64
+	Signatures uint64
+	// Final is true when the update becomes the new head, otherwise it is stored as a new candidate
+	Final bool
+}
+
+// VerifyStep reproduces all checks of BeaconLightClient.step for the update against the given contract state,
+// so that invalid updates are caught before spending gas on them
+func (c *LightClient) VerifyStep(state *ContractState, update *Update) (*StepResult, error) {
+	hasFinalityProof := len(update.FinalityBranch) > 0
+	activeHeader := update.AttestedHeader
+	if hasFinalityProof {
+		activeHeader = update.FinalizedHeader
+	} else if update.FinalizedHeader.Slot != 0 {
+		return nil, stepError(InvalidFinalizedHeaderError, "finalized header slot %d without finality branch", update.FinalizedHeader.Slot)
+	}
+
+	if activeHeader.Slot <= state.Head {
+		return nil, stepError(OldUpdateError, "update slot %d, head %d", activeHeader.Slot, state.Head)
+	}
+	if state.Timestamp < uint64(c.Genesis.GenesisTime.Unix()) {
+		return nil, stepError(ContractPanicError, "arithmetic underflow, timestamp %d is before genesis", state.Timestamp)
+	}
+	curSlot := (state.Timestamp - uint64(c.Genesis.GenesisTime.Unix())) / c.Spec.SecondsPerSlot
+	if activeHeader.Slot > curSlot {
+		return nil, stepError(FutureUpdateError, "update slot %d, current slot %d", activeHeader.Slot, curSlot)
+	}
+
+	slotsPerPeriod := c.Spec.EpochsPerSyncCommitteePeriod * c.Spec.SlotsPerEpoch
+	var syncCommitteeIndex, otherIndex int
+	switch update.SignatureSlot / slotsPerPeriod {
+	case state.Head / slotsPerPeriod:
+		syncCommitteeIndex, otherIndex = ContractGenIndices.CurrentSyncCommittee, ContractGenIndices.NextSyncCommittee
+	case state.Head/slotsPerPeriod + 1:
+		syncCommitteeIndex, otherIndex = ContractGenIndices.NextSyncCommittee, ContractGenIndices.CurrentSyncCommittee
+	default:
+		return nil, stepError(FutureSignatureError, "signature slot %d is in period %d, head %d is in period %d",
+			update.SignatureSlot, update.SignatureSlot/slotsPerPeriod, state.Head, state.Head/slotsPerPeriod)
+	}
+
+	attestedRoot := update.AttestedHeader.HashTreeRoot()
+	activeRoot := attestedRoot
+	if hasFinalityProof {
+		activeRoot = update.FinalizedHeader.HashTreeRoot()
+		restoredStateRoot, err := restoreMerkleRoot(activeRoot, ContractGenIndices.FinalizedRoot(), update.FinalityBranch)
+		if err != nil {
+			return nil, err
+		}
+		if restoredStateRoot != update.AttestedHeader.StateRoot {
+			return nil, stepError(FinalityProofError, "restored state root %s, attested state root %s", restoredStateRoot, update.AttestedHeader.StateRoot)
+		}
+	}
+
+	size := uint64(c.Spec.SyncCommitteeSize)
+	missed := uint64(len(update.MissedSyncCommitteeParticipants))
+	if missed > size {
+		return nil, stepError(ContractPanicError, "arithmetic underflow, %d missed participants", missed)
+	}
+	count := size - missed
+	if count < MinSyncCommitteeParticipants {
+		return nil, stepError(NotEnoughSignaturesError, "%d signatures", count)
+	}
+	indices, leaves, aggregatedPK, err := c.aggregateMissingPubkeys(update)
+	if err != nil {
+		return nil, err
+	}
+
+	syncCommitteeRoot, err := restoreMerkleMultiRoot(indices, leaves, update.SyncCommitteeRootDecommitments)
+	if err != nil {
+		return nil, err
+	}
+	syncCommitteeRoot = crypto.Sha256Hash(syncCommitteeRoot.Bytes(), crypto.HashG1Point(aggregatedPK).Bytes())
+	restoredStateRoot, err := restoreMerkleRoot(syncCommitteeRoot, syncCommitteeIndex, update.SyncCommitteeBranch)
+	if err != nil {
+		return nil, err
+	}
+	if restoredStateRoot != state.HeadStateRoot {
+		details := fmt.Sprintf("restored state root %s for index %d, head %d state root %s", restoredStateRoot, syncCommitteeIndex, state.Head, state.HeadStateRoot)
+		if root, err2 := restoreMerkleRoot(syncCommitteeRoot, otherIndex, update.SyncCommitteeBranch); err2 == nil && root == state.HeadStateRoot {
+			details += fmt.Sprintf(", but the proof is valid for index %d", otherIndex)
+		}
+		return nil, &StepError{Reason: SyncCommitteeProofError, Details: details}
+	}
+
+	pk, err := update.SyncAggregatePubkey.PublicKey()
+	if err != nil {
+		return nil, stepError(InvalidPointError, "sync aggregate public key: %s", err)
+	}
+	sig, err := update.SyncAggregateSignature.Signature()
+	if err != nil {
+		return nil, stepError(InvalidPointError, "sync aggregate signature: %s", err)
+	}
+	domainRoot := c.syncDomainRootForVersion(update.ForkVersion)
+	signRoot := crypto.Sha256Hash(attestedRoot.Bytes(), domainRoot.Bytes())
+	if !sig.Verify(pk, signRoot.Bytes()) {
+		details := fmt.Sprintf("attested root %s, fork version %x", attestedRoot, update.ForkVersion)
+		if expected := c.signatureForkVersion(update.SignatureSlot); expected != update.ForkVersion {
+			details += fmt.Sprintf(", but signature slot %d belongs to fork version %x", update.SignatureSlot, expected)
+		}
+		return nil, &StepError{Reason: InvalidSignatureError, Details: details}
+	}
+
+	res := &StepResult{
+		ActiveSlot: activeHeader.Slot,
+		Signatures: count,
+		Final:      3*count >= 2*size && hasFinalityProof,
+	}
+	if !res.Final && state.BestValidUpdate.Slot > state.Head && count <= state.BestValidUpdate.Signatures {
+		return nil, stepError(NotBestCandidateError, "%d signatures, stored candidate for slot %d has %d signatures",
+			count, state.BestValidUpdate.Slot, state.BestValidUpdate.Signatures)
+	}
+	return res, nil
+}
+
+// aggregateMissingPubkeys mirrors _aggregateMissingPubkeys, walking through the sync committee bits in the reversed order
+func (c *LightClient) aggregateMissingPubkeys(update *Update) ([]int, []common.Hash, *crypto.G1Point, error) {
+	size := c.Spec.SyncCommitteeSize
+	if len(update.SyncAggregateBitList) != size/256 {
+		return nil, nil, nil, stepError(ContractPanicError, "sync aggregate bit list has %d words", len(update.SyncAggregateBitList))
+	}
+	pk, err := update.SyncAggregatePubkey.PublicKey()
+	if err != nil {
+		return nil, nil, nil, stepError(InvalidPointError, "sync aggregate public key: %s", err)
+	}
+	result := pk.Copy()
+	pks := update.MissedSyncCommitteeParticipants
+	indices := make([]int, 0, len(pks))
+	leaves := make([]common.Hash, 0, len(pks))
+	var word common.Hash
+	for i := size*2 - 1; i >= size; i-- {
+		m := i & 0xff
+		if m == 0xff {
+			word = update.SyncAggregateBitList[(i>>8)-2]
+		}
+		if word[31-m/8]&(1<<(m%8)) == 0 {
+			count := len(indices)
+			if count >= len(pks) {
+				return nil, nil, nil, stepError(ContractPanicError, "index out of bounds, more than %d participants are missed in the bit list", len(pks))
+			}
+			missedPK, err := pks[count].PublicKey()
+			if err != nil {
+				return nil, nil, nil, stepError(InvalidPointError, "missed participant %d: %s", count, err)
+			}
+			result = result.Aggregate(missedPK)
+			indices = append(indices, i)
+			leaves = append(leaves, crypto.HashG1PointCompressed(&pks[count]))
+		}
+	}
+	if len(indices) != len(pks) {
+		return nil, nil, nil, stepError(MissedParticipantsError, "%d missed participants in the bit list, %d public keys", len(indices), len(pks))
+	}
+	aggregated := crypto.PkToG1(result)
+	return indices, leaves, &aggregated, nil
+}
+
+// restoreMerkleRoot mirrors Merkle.restoreMerkleRoot
+func restoreMerkleRoot(leaf common.Hash, genIndex int, proof []common.Hash) (common.Hash, error) {
+	if len(proof) >= 64 || genIndex>>len(proof) != 1 {
+		return common.Hash{}, stepError(InvalidProofLengthError, "%d hashes for generalized index %d", len(proof), genIndex)
+	}
+	for i := range proof {
+		if genIndex&(1<<i) == 0 {
+			leaf = crypto.Sha256Hash(leaf.Bytes(), proof[i].Bytes())
+		} else {
+			leaf = crypto.Sha256Hash(proof[i].Bytes(), leaf.Bytes())
+		}
+	}
+	return leaf, nil
+}
+
+// restoreMerkleMultiRoot mirrors Merkle.restoreMerkleMultiRoot, including its circular queue over the input arrays
+func restoreMerkleMultiRoot(indices []int, hashes []common.Hash, decommitments []common.Hash) (common.Hash, error) {
+	n := len(indices)
+	if n != len(hashes) {
+		return common.Hash{}, stepError(MultiProofError, "%d indices, %d hashes", n, len(hashes))
+	}
+	indices = append([]int{}, indices...)
+	hashes = append([]common.Hash{}, hashes...)
+	decommitment := func(di int) (common.Hash, error) {
+		if di >= len(decommitments) {
+			return common.Hash{}, stepError(ContractPanicError, "decommitment %d is out of bounds", di)
+		}
+		return decommitments[di], nil
+	}
+	if n == 0 {
+		return decommitment(0)
+	}
+
+	head, tail, di := 0, 0, 0
+	for {
+		index, hash := indices[head], hashes[head]
+		head = (head + 1) % n
+
+		if index == 1 {
+			return hash, nil
+		} else if index&1 == 0 {
+			d, err := decommitment(di)
+			if err != nil {
+				return common.Hash{}, err
+			}
+			di++
+			hash = crypto.Sha256Hash(hash.Bytes(), d.Bytes())
+		} else if indices[head] == index-1 && head != tail {
+			hash = crypto.Sha256Hash(hashes[head].Bytes(), hash.Bytes())
+			head = (head + 1) % n
+		} else {
+			d, err := decommitment(di)
+			if err != nil {
+				return common.Hash{}, err
+			}
+			di++
+			hash = crypto.Sha256Hash(d.Bytes(), hash.Bytes())
+		}
+
+		indices[tail], hashes[tail] = index/2, hash
+		tail = (tail + 1) % n
+	}
+}
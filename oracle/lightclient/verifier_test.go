@@ -0,0 +1,153 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/crypto"
+	"oracle/testchain"
+)
+
+func TestVerifyStep(t *testing.T) {
+	ctx := context.Background()
+	chain, err := testchain.NewChain(testchain.Config{
+		Participation: func(slot uint64) int { return 400 },
+	})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(40))
+	c := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis, WithFinality: true}
+	genesis, err := chain.GetBlock(ctx, "genesis")
+	require.NoError(t, err)
+	state := func() *ContractState {
+		return &ContractState{
+			HeadStateRoot: genesis.StateRoot,
+			Timestamp:     uint64(chain.Genesis.GenesisTime.Unix()) + 41*chain.Spec.SecondsPerSlot,
+		}
+	}
+
+	// update is signed by the next sync committee of the genesis state
+	update, err := c.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	res, err := c.VerifyStep(state(), update)
+	require.NoError(t, err)
+	assert.Equal(t, &StepResult{ActiveSlot: 16, Signatures: 400, Final: true}, res)
+
+	// updates decoded from json files are verified in the same way
+	data, err := json.Marshal(update)
+	require.NoError(t, err)
+	var decoded Update
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	res, err = c.VerifyStep(state(), &decoded)
+	require.NoError(t, err)
+	assert.True(t, res.Final)
+
+	for name, tc := range map[string]struct {
+		state  func(s *ContractState)
+		update func(u *Update)
+		reason error
+	}{
+		"old update": {
+			state:  func(s *ContractState) { s.Head = 16 },
+			reason: OldUpdateError,
+		},
+		"future update": {
+			state:  func(s *ContractState) { s.Timestamp -= 30 * chain.Spec.SecondsPerSlot },
+			reason: FutureUpdateError,
+		},
+		"future signature": {
+			update: func(u *Update) { u.SignatureSlot += 64 },
+			reason: FutureSignatureError,
+		},
+		"finalized header without finality": {
+			update: func(u *Update) { u.FinalityBranch = nil },
+			reason: InvalidFinalizedHeaderError,
+		},
+		"finality proof": {
+			update: func(u *Update) { u.FinalizedHeader.ProposerIndex++ },
+			reason: FinalityProofError,
+		},
+		"finality proof length": {
+			update: func(u *Update) { u.FinalityBranch = u.FinalityBranch[1:] },
+			reason: InvalidProofLengthError,
+		},
+		"extra missed participant": {
+			update: func(u *Update) {
+				u.MissedSyncCommitteeParticipants = append(u.MissedSyncCommitteeParticipants, u.MissedSyncCommitteeParticipants[0])
+			},
+			reason: MissedParticipantsError,
+		},
+		"missing missed participant": {
+			update: func(u *Update) { u.MissedSyncCommitteeParticipants = u.MissedSyncCommitteeParticipants[1:] },
+			reason: ContractPanicError,
+		},
+		"sync committee proof": {
+			update: func(u *Update) { u.SyncCommitteeRootDecommitments[0] = common.Hash{1} },
+			reason: SyncCommitteeProofError,
+		},
+		"fork version": {
+			update: func(u *Update) { u.ForkVersion = [4]byte{1} },
+			reason: InvalidSignatureError,
+		},
+	} {
+		s := state()
+		if tc.state != nil {
+			tc.state(s)
+		}
+		var u Update
+		require.NoError(t, json.Unmarshal(data, &u))
+		if tc.update != nil {
+			tc.update(&u)
+		}
+		_, err = c.VerifyStep(s, &u)
+		assert.ErrorIs(t, err, tc.reason, name)
+	}
+
+	// the most common mistake is proving the current sync committee instead of the next one and vice versa
+	update, err = c.MakeUpdate(ctx, 0, 29)
+	require.NoError(t, err)
+	update.SignatureSlot = 33
+	_, err = c.VerifyStep(state(), update)
+	require.ErrorIs(t, err, SyncCommitteeProofError)
+	assert.Contains(t, err.Error(), "but the proof is valid for index 54")
+
+	// candidates without finality have to beat the stored candidate
+	optimistic := &LightClient{Client: chain, Spec: chain.Spec, Genesis: chain.Genesis}
+	candidate, err := optimistic.MakeUpdate(ctx, 0, 0)
+	require.NoError(t, err)
+	res, err = optimistic.VerifyStep(state(), candidate)
+	require.NoError(t, err)
+	assert.Equal(t, &StepResult{ActiveSlot: 39, Signatures: 400}, res)
+	s := state()
+	s.BestValidUpdate = BestValidUpdate{Slot: 20, Signatures: 400}
+	_, err = optimistic.VerifyStep(s, candidate)
+	assert.ErrorIs(t, err, NotBestCandidateError)
+}
+
+func TestRestoreMerkleMultiRoot(t *testing.T) {
+	leaves := []common.Hash{{1}, {2}, {3}, {4}, {5}, {6}, {7}, {8}}
+	tree := crypto.NewVectorMerkleTree(leaves...)
+	for _, indices := range [][]int{{0}, {6, 7}, {1, 2, 5, 7}, {0, 1, 2, 3, 4, 5, 6}} {
+		proof := tree.MakeMultiProof(indices)
+		// the contract expects leaves in the descending order
+		var genIndices []int
+		var hashes []common.Hash
+		for i := len(indices) - 1; i >= 0; i-- {
+			genIndices = append(genIndices, indices[i]+len(leaves))
+			hashes = append(hashes, leaves[indices[i]])
+		}
+		root, err := restoreMerkleMultiRoot(genIndices, hashes, proof.Decommitments)
+		require.NoError(t, err)
+		assert.Equal(t, tree.Hash(), root, "%v", indices)
+	}
+
+	_, err := restoreMerkleMultiRoot([]int{8}, []common.Hash{{1}}, nil)
+	assert.ErrorIs(t, err, ContractPanicError)
+	_, err = restoreMerkleMultiRoot([]int{8}, nil, nil)
+	assert.ErrorIs(t, err, MultiProofError)
+}
@@ -0,0 +1,586 @@
+// Package testchain generates an in-memory beacon chain for tests, starting at Bellatrix and upgrading to Capella when it is scheduled.
+// Blocks carry real sync aggregate signatures made by the committees of deterministic test keys,
+// while everything unrelated to the light client (attestations, randao, deposits, etc.) is left empty.
+package testchain
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/go-bitfield"
+
+	"oracle/config"
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+const (
+	farFutureEpoch      = 1<<64 - 1
+	maxEffectiveBalance = 32e9
+)
+
+// DefaultSpec returns the mainnet preset with shortened epochs and sync committee periods,
+// sizes of ssz vectors (sync committee, block roots, etc.) are fixed by forks package and are left intact
+func DefaultSpec() *config.SpecConfig {
+	return &config.SpecConfig{
+		SecondsPerSlot:               12,
+		SlotsPerEpoch:                8,
+		AltairForkVersion:            "0x01000000",
+		BellatrixForkVersion:         "0x02000000",
+		EpochsPerSyncCommitteePeriod: 4,
+		SyncCommitteeSize:            512,
+		ValidatorRegistryLimit:       1 << 40,
+		HistoricalRootsLimit:         1 << 24,
+		// keeps eth1_data_votes limit equal to 2048 from the mainnet preset
+		EpochsPerEth1VotingPeriod: 256,
+		SlotsPerHistoricalRoot:    8192,
+
+		PendingDepositsLimit:           1 << 27,
+		PendingPartialWithdrawalsLimit: 1 << 27,
+		PendingConsolidationsLimit:     1 << 18,
+	}
+}
+
+type Config struct {
+	// Spec defaults to DefaultSpec
+	Spec *config.SpecConfig
+	// GenesisTime defaults to a fixed timestamp, so that generated chains are reproducible
+	GenesisTime time.Time
+	// Seed determines validator keys, execution payloads and sync committee participants
+	Seed int64
+	// Validators is the size of the validator registry, by default it is 5/4 of the sync committee size.
+	// Sync committee of each next period is taken from the registry with a shift, so that committees rotate.
+	Validators int
+	// Participation returns the number of sync committee members signing the block at the given slot,
+	// by default all members sign
+	Participation func(slot uint64) int
+	// Missed tells whether the block at the given slot is not proposed
+	Missed func(slot uint64) bool
+	// FinalizedEpoch returns the epoch of the finalized checkpoint in the states of the given epoch,
+	// by default finality lags two epochs behind. The result is kept between the last finalized and the previous epochs.
+	FinalizedEpoch func(epoch uint64) uint64
+}
+
+// Chain is a synthetic beacon chain, implementing beaconclient.Eth2Client.
+// It starts with the genesis block and is extended by AdvanceTo.
+type Chain struct {
+	Spec    *config.SpecConfig
+	Genesis *config.GenesisConfig
+
+	cfg  Config
+	keys []*secretKey
+
+	mu     sync.RWMutex
+	head   common.Hash
+	blocks map[common.Hash]*forks.BeaconBlock
+	// roots of the blocks by slot, zero for the missed slots
+	slots []common.Hash
+	// states are kept in the fork independent layout, Raw holds the fork specific state
+	states     []*forks.BeaconState
+	stateRoots []common.Hash
+}
+
+// supported fork specific types, the chain itself is built with the latest ones and converted
+var (
+	stateTypes = map[forks.Version]reflect.Type{
+		forks.Bellatrix: reflect.TypeOf(forks.BeaconStateBellatrix{}),
+		forks.Capella:   reflect.TypeOf(forks.BeaconStateCapella{}),
+	}
+	signedBlockTypes = map[forks.Version]reflect.Type{
+		forks.Bellatrix: reflect.TypeOf(forks.SignedBeaconBlockBellatrix{}),
+		forks.Capella:   reflect.TypeOf(forks.SignedBeaconBlockCapella{}),
+	}
+)
+
+func NewChain(cfg Config) (*Chain, error) {
+	if cfg.Spec == nil {
+		cfg.Spec = DefaultSpec()
+	}
+	if cfg.GenesisTime.IsZero() {
+		cfg.GenesisTime = time.Unix(1606824023, 0)
+	}
+	if cfg.Validators == 0 {
+		cfg.Validators = cfg.Spec.SyncCommitteeSize * 5 / 4
+	}
+	if cfg.Validators < cfg.Spec.SyncCommitteeSize {
+		return nil, fmt.Errorf("can't fill sync committee of size %d with %d validators", cfg.Spec.SyncCommitteeSize, cfg.Validators)
+	}
+	if cfg.Spec.DenebForkVersion != "" || cfg.Spec.ElectraForkVersion != "" || cfg.Spec.FuluForkVersion != "" {
+		return nil, fmt.Errorf("only bellatrix and capella forks are supported")
+	}
+	keys, err := deriveKeys(cfg.Seed, cfg.Validators)
+	if err != nil {
+		return nil, fmt.Errorf("can't generate validator keys: %w", err)
+	}
+	c := &Chain{
+		Spec:   cfg.Spec,
+		cfg:    cfg,
+		keys:   keys,
+		blocks: make(map[common.Hash]*forks.BeaconBlock),
+	}
+	if err = c.makeGenesis(); err != nil {
+		return nil, fmt.Errorf("can't make genesis: %w", err)
+	}
+	return c, nil
+}
+
+func (c *Chain) makeGenesis() error {
+	zero := make([]byte, 32)
+	validators := make([]*forks.Validator, len(c.keys))
+	balances := make([]uint64, len(c.keys))
+	for i, key := range c.keys {
+		validators[i] = &forks.Validator{
+			Pubkey:                key.pubkey,
+			WithdrawalCredentials: zero,
+			EffectiveBalance:      maxEffectiveBalance,
+			ExitEpoch:             farFutureEpoch,
+			WithdrawableEpoch:     farFutureEpoch,
+		}
+		balances[i] = maxEffectiveBalance
+	}
+	genesisValidatorsRoot := crypto.HashContainersList(validators, c.Spec.ValidatorRegistryLimit)
+	c.Genesis = &config.GenesisConfig{
+		GenesisTime:           c.cfg.GenesisTime,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+
+	currentSyncCommittee, err := c.syncCommittee(0)
+	if err != nil {
+		return err
+	}
+	nextSyncCommittee, err := c.syncCommittee(1)
+	if err != nil {
+		return err
+	}
+	eth1Data := &forks.Eth1Data{DepositRoot: zero, DepositCount: uint64(len(c.keys)), BlockHash: zero}
+	version := c.versionAt(0)
+	block := &forks.BeaconBlockCapella{
+		ParentRoot: zero,
+		Body: newBlockBody(eth1Data, &forks.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, 64),
+			SyncCommitteeSignature: infinitySignature(),
+		}, newEmptyPayload()),
+	}
+	bodyRoot, err := blockBodyRoot(version, block)
+	if err != nil {
+		return err
+	}
+	fork := &forks.Fork{
+		PreviousVersion: common.FromHex(c.Spec.AltairForkVersion),
+		CurrentVersion:  common.FromHex(c.Spec.BellatrixForkVersion),
+		Epoch:           c.Spec.BellatrixForkEpoch,
+	}
+	if version == forks.Capella {
+		fork = &forks.Fork{
+			PreviousVersion: common.FromHex(c.Spec.BellatrixForkVersion),
+			CurrentVersion:  common.FromHex(c.Spec.CapellaForkVersion),
+			Epoch:           c.Spec.CapellaForkEpoch,
+		}
+	}
+	checkpoint := &forks.Checkpoint{Root: zero}
+	state := &forks.BeaconState{
+		Version:               version,
+		GenesisTime:           uint64(c.cfg.GenesisTime.Unix()),
+		GenesisValidatorsRoot: genesisValidatorsRoot.Bytes(),
+		Fork:                  fork,
+		LatestBlockHeader:     &forks.BeaconBlockHeader{ParentRoot: zero, StateRoot: zero, BodyRoot: bodyRoot[:]},
+		BlockRoots:            repeat(zero, 8192),
+		StateRoots:            repeat(zero, 8192),
+		HistoricalRoots:       [][]byte{},
+		Eth1Data:              eth1Data,
+		Eth1DataVotes:         []*forks.Eth1Data{},
+		Eth1DepositIndex:      uint64(len(c.keys)),
+		Validators:            validators,
+		Balances:              balances,
+		RandaoMixes:           repeat(zero, 65536),
+		Slashings:             make([]uint64, 8192),
+		// participation is not tracked, besides fastssz hashes byte lists longer than 32 bytes incorrectly
+		PreviousEpochParticipation:   []byte{},
+		CurrentEpochParticipation:    []byte{},
+		JustificationBits:            []byte{0},
+		PreviousJustifiedCheckpoint:  checkpoint,
+		CurrentJustifiedCheckpoint:   checkpoint,
+		FinalizedCheckpoint:          checkpoint,
+		InactivityScores:             make([]uint64, len(c.keys)),
+		CurrentSyncCommittee:         currentSyncCommittee,
+		NextSyncCommittee:            nextSyncCommittee,
+		LatestExecutionPayloadHeader: toPayloadHeader(block.Body.ExecutionPayload),
+		HistoricalSummaries:          []*forks.HistoricalSummary{},
+	}
+	return c.addBlock(state, block)
+}
+
+// versionAt returns the fork of the states at the given epoch
+func (c *Chain) versionAt(epoch uint64) forks.Version {
+	if c.Spec.CapellaForkVersion != "" && epoch >= c.Spec.CapellaForkEpoch {
+		return forks.Capella
+	}
+	return forks.Bellatrix
+}
+
+// syncDomain returns the domain of sync committee signatures included at the given slot
+func (c *Chain) syncDomain(signatureSlot uint64) common.Hash {
+	epoch := uint64(0)
+	if signatureSlot > 0 {
+		epoch = (signatureSlot - 1) / c.Spec.SlotsPerEpoch
+	}
+	return computeDomain(domainSyncCommittee, c.Spec.ForkVersionAt(epoch), c.Genesis.GenesisValidatorsRoot)
+}
+
+// AdvanceTo extends the chain up to the given slot, producing blocks at all slots that are not missed
+func (c *Chain) AdvanceTo(slot uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for uint64(len(c.states)) <= slot {
+		state := c.processSlot()
+		if c.cfg.Missed != nil && c.cfg.Missed(state.Slot) {
+			root, err := hashState(state)
+			if err != nil {
+				return err
+			}
+			c.slots = append(c.slots, common.Hash{})
+			c.states = append(c.states, state)
+			c.stateRoots = append(c.stateRoots, root)
+			continue
+		}
+		if err := c.processBlock(state); err != nil {
+			return fmt.Errorf("can't make block at slot %d: %w", state.Slot, err)
+		}
+	}
+	return nil
+}
+
+// Head returns the latest produced block
+func (c *Chain) Head() *forks.BeaconBlock {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.blocks[c.head]
+}
+
+// processSlot makes the state for the next slot, as specified by process_slots
+func (c *Chain) processSlot() *forks.BeaconState {
+	prev := c.states[len(c.states)-1]
+	prevRoot := c.stateRoots[len(c.stateRoots)-1]
+
+	// stored states are never modified, so that all fields changed here are copied first
+	state := *prev
+	state.BlockRoots = append([][]byte{}, prev.BlockRoots...)
+	state.StateRoots = append([][]byte{}, prev.StateRoots...)
+	header := *prev.LatestBlockHeader
+	state.LatestBlockHeader = &header
+
+	i := state.Slot % uint64(len(state.StateRoots))
+	state.StateRoots[i] = prevRoot.Bytes()
+	if common.BytesToHash(header.StateRoot) == (common.Hash{}) {
+		header.StateRoot = prevRoot.Bytes()
+	}
+	state.BlockRoots[i] = crypto.MustHashTreeRoot(&header).Bytes()
+	state.Slot++
+	if state.Slot%c.Spec.SlotsPerEpoch == 0 {
+		c.processEpoch(&state)
+		c.upgrade(&state)
+	}
+	return &state
+}
+
+// upgrade switches the state to the fork scheduled at the new epoch, as specified by upgrade_to_capella
+func (c *Chain) upgrade(state *forks.BeaconState) {
+	epoch := state.Slot / c.Spec.SlotsPerEpoch
+	version := c.versionAt(epoch)
+	if version == state.Version {
+		return
+	}
+	state.Version = version
+	state.Fork = &forks.Fork{
+		PreviousVersion: state.Fork.CurrentVersion,
+		CurrentVersion:  common.FromHex(c.Spec.CapellaForkVersion),
+		Epoch:           epoch,
+	}
+	header := *state.LatestExecutionPayloadHeader
+	header.WithdrawalsRoot = make([]byte, 32)
+	state.LatestExecutionPayloadHeader = &header
+	state.HistoricalSummaries = []*forks.HistoricalSummary{}
+}
+
+// processEpoch advances finality and rotates sync committees, the rest of the epoch processing is not needed
+func (c *Chain) processEpoch(state *forks.BeaconState) {
+	epoch := state.Slot / c.Spec.SlotsPerEpoch
+	if state.Slot%uint64(len(state.BlockRoots)) == 0 {
+		batchRoot := crypto.Sha256Hash(
+			crypto.HashRootsVector(state.BlockRoots).Bytes(),
+			crypto.HashRootsVector(state.StateRoots).Bytes(),
+		)
+		state.HistoricalRoots = append(append([][]byte{}, state.HistoricalRoots...), batchRoot.Bytes())
+	}
+
+	state.PreviousJustifiedCheckpoint = state.CurrentJustifiedCheckpoint
+	state.CurrentJustifiedCheckpoint = c.checkpoint(state, epoch-1)
+	finalized := epoch - 1
+	if c.cfg.FinalizedEpoch != nil {
+		if e := c.cfg.FinalizedEpoch(epoch); e < finalized {
+			finalized = e
+		}
+	} else if epoch >= 2 {
+		finalized = epoch - 2
+	}
+	if finalized > state.FinalizedCheckpoint.Epoch || epoch == 1 {
+		state.FinalizedCheckpoint = c.checkpoint(state, finalized)
+	}
+
+	slotsPerPeriod := c.Spec.SlotsPerEpoch * c.Spec.EpochsPerSyncCommitteePeriod
+	if state.Slot%slotsPerPeriod == 0 {
+		next, err := c.syncCommittee(state.Slot/slotsPerPeriod + 1)
+		if err != nil {
+			// keys are validated when the chain is created, so the committee can always be made
+			panic(err)
+		}
+		state.CurrentSyncCommittee = state.NextSyncCommittee
+		state.NextSyncCommittee = next
+	}
+}
+
+// checkpoint returns the checkpoint with the root of the block at the start of the given epoch
+func (c *Chain) checkpoint(state *forks.BeaconState, epoch uint64) *forks.Checkpoint {
+	slot := epoch * c.Spec.SlotsPerEpoch
+	return &forks.Checkpoint{Epoch: epoch, Root: state.BlockRoots[slot%uint64(len(state.BlockRoots))]}
+}
+
+func (c *Chain) processBlock(state *forks.BeaconState) error {
+	slot := state.Slot
+	parentRoot := crypto.MustHashTreeRoot(state.LatestBlockHeader)
+	slotsPerPeriod := c.Spec.SlotsPerEpoch * c.Spec.EpochsPerSyncCommitteePeriod
+	aggregate, err := c.signSyncAggregate(slot, c.syncCommitteeKeys(slot/slotsPerPeriod), parentRoot)
+	if err != nil {
+		return err
+	}
+
+	prevPayload := state.LatestExecutionPayloadHeader
+	number := prevPayload.BlockNumber + 1
+	payload := newEmptyPayload()
+	payload.ParentHash = prevPayload.BlockHash
+	payload.StateRoot = c.executionHash("state", number).Bytes()
+	payload.ReceiptsRoot = c.executionHash("receipts", number).Bytes()
+	payload.BlockNumber = number
+	payload.GasLimit = 30_000_000
+	payload.Timestamp = state.GenesisTime + slot*c.Spec.SecondsPerSlot
+	payload.BlockHash = c.executionHash("block", number).Bytes()
+
+	block := &forks.BeaconBlockCapella{
+		Slot:          slot,
+		ProposerIndex: slot % uint64(len(c.keys)),
+		ParentRoot:    parentRoot.Bytes(),
+		Body:          newBlockBody(state.Eth1Data, aggregate, payload),
+	}
+	bodyRoot, err := blockBodyRoot(state.Version, block)
+	if err != nil {
+		return err
+	}
+	state.LatestBlockHeader = &forks.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		StateRoot:     make([]byte, 32),
+		BodyRoot:      bodyRoot[:],
+	}
+	state.LatestExecutionPayloadHeader = toPayloadHeader(payload)
+	return c.addBlock(state, block)
+}
+
+// addBlock stores the post state of the given block, filling in the block state root
+func (c *Chain) addBlock(state *forks.BeaconState, message *forks.BeaconBlockCapella) error {
+	stateRoot, err := hashState(state)
+	if err != nil {
+		return err
+	}
+	message.StateRoot = stateRoot[:]
+	block, err := newBeaconBlock(state.Version, message)
+	if err != nil {
+		return err
+	}
+	root := block.Root()
+	c.head = root
+	c.blocks[root] = block
+	c.slots = append(c.slots, root)
+	c.states = append(c.states, state)
+	c.stateRoots = append(c.stateRoots, stateRoot)
+	return nil
+}
+
+// signSyncAggregate signs the attested block root by the pseudo-random subset of the committee
+func (c *Chain) signSyncAggregate(slot uint64, committee []*secretKey, root common.Hash) (*forks.SyncAggregate, error) {
+	n := len(committee)
+	if c.cfg.Participation != nil {
+		if n = c.cfg.Participation(slot); n < 0 {
+			n = 0
+		} else if n > len(committee) {
+			n = len(committee)
+		}
+	}
+	bits := bitfield.NewBitvector512()
+	var signers []*secretKey
+	for _, i := range rand.New(rand.NewSource(c.cfg.Seed ^ int64(slot))).Perm(len(committee))[:n] {
+		bits.SetBitAt(uint64(i), true)
+		signers = append(signers, committee[i])
+	}
+	aggregate := &forks.SyncAggregate{SyncCommitteeBits: bits, SyncCommitteeSignature: infinitySignature()}
+	if n == 0 {
+		return aggregate, nil
+	}
+	sig, err := sign(aggregateKey(signers), root, c.syncDomain(slot))
+	if err != nil {
+		return nil, fmt.Errorf("can't sign sync aggregate: %w", err)
+	}
+	aggregate.SyncCommitteeSignature = sig
+	return aggregate, nil
+}
+
+// syncCommitteeKeys returns keys of the sync committee of the given period,
+// committees are consecutive validators, starting with the shift that grows every period
+func (c *Chain) syncCommitteeKeys(period uint64) []*secretKey {
+	size := c.Spec.SyncCommitteeSize
+	shift := int(period%uint64(len(c.keys))) * (len(c.keys) - size)
+	keys := make([]*secretKey, size)
+	for i := range keys {
+		keys[i] = c.keys[(shift+i)%len(c.keys)]
+	}
+	return keys
+}
+
+func (c *Chain) syncCommittee(period uint64) (*forks.SyncCommittee, error) {
+	keys := c.syncCommitteeKeys(period)
+	committee := &forks.SyncCommittee{}
+	for _, key := range keys {
+		committee.Pubkeys = append(committee.Pubkeys, key.pubkey)
+	}
+	sk, err := toBLSKey(aggregateKey(keys))
+	if err != nil {
+		return nil, err
+	}
+	committee.AggregatePubkey = sk.PublicKey().Marshal()
+	return committee, nil
+}
+
+func (c *Chain) executionHash(kind string, number uint64) common.Hash {
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], uint64(c.cfg.Seed))
+	binary.LittleEndian.PutUint64(buf[8:], number)
+	return crypto.Sha256Hash([]byte(kind), buf[:])
+}
+
+func newBlockBody(eth1Data *forks.Eth1Data, aggregate *forks.SyncAggregate, payload *forks.ExecutionPayloadCapella) *forks.BeaconBlockBodyCapella {
+	return &forks.BeaconBlockBodyCapella{
+		RandaoReveal:          make([]byte, 96),
+		Eth1Data:              eth1Data,
+		Graffiti:              make([]byte, 32),
+		SyncAggregate:         aggregate,
+		ExecutionPayload:      payload,
+		BLSToExecutionChanges: []*forks.SignedBLSToExecutionChange{},
+	}
+}
+
+func newEmptyPayload() *forks.ExecutionPayloadCapella {
+	return &forks.ExecutionPayloadCapella{
+		ParentHash:    make([]byte, 32),
+		FeeRecipient:  make([]byte, 20),
+		StateRoot:     make([]byte, 32),
+		ReceiptsRoot:  make([]byte, 32),
+		LogsBloom:     make([]byte, 256),
+		PrevRandao:    make([]byte, 32),
+		ExtraData:     []byte{},
+		BaseFeePerGas: make([]byte, 32),
+		BlockHash:     make([]byte, 32),
+		Transactions:  [][]byte{},
+		Withdrawals:   []*forks.Withdrawal{},
+	}
+}
+
+// toPayloadHeader converts the payload without transactions and withdrawals into the header
+func toPayloadHeader(payload *forks.ExecutionPayloadCapella) *forks.ExecutionPayloadHeaderDeneb {
+	return &forks.ExecutionPayloadHeaderDeneb{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        payload.ExtraData,
+		BaseFeePerGas:    payload.BaseFeePerGas,
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: crypto.HashRootsList(nil, 1<<20).Bytes(),
+		WithdrawalsRoot:  crypto.HashRootsList(nil, 16).Bytes(),
+	}
+}
+
+// hashState converts the state into its fork specific layout and returns the state root
+func hashState(state *forks.BeaconState) (common.Hash, error) {
+	raw := reflect.New(stateTypes[state.Version])
+	convert(raw.Elem(), reflect.ValueOf(state).Elem())
+	state.Raw = raw.Interface().(forks.Object)
+	root, err := state.Raw.HashTreeRoot()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("can't calculate state root: %w", err)
+	}
+	return root, nil
+}
+
+// newBeaconBlock converts the block into its fork specific layout
+func newBeaconBlock(version forks.Version, message *forks.BeaconBlockCapella) (*forks.BeaconBlock, error) {
+	signed := reflect.New(signedBlockTypes[version])
+	convert(signed.Elem(), reflect.ValueOf(forks.SignedBeaconBlockCapella{
+		Message: message,
+		// proposer signatures are not verified by the light client
+		Signature: make([]byte, 96),
+	}))
+	return forks.NewBeaconBlock(version, signed.Interface().(forks.Object))
+}
+
+func blockBodyRoot(version forks.Version, message *forks.BeaconBlockCapella) (common.Hash, error) {
+	block, err := newBeaconBlock(version, message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.BodyRoot, nil
+}
+
+// convert copies the fields of src struct into the fields of dst struct with the same names.
+// Nested containers of different types are converted recursively, so that the structs of the later forks
+// can be converted into the earlier ones.
+func convert(dst, src reflect.Value) {
+	for i := 0; i < dst.NumField(); i++ {
+		d := dst.Field(i)
+		s := src.FieldByName(dst.Type().Field(i).Name)
+		switch {
+		case !s.IsValid():
+		case s.Type().AssignableTo(d.Type()):
+			d.Set(s)
+		case d.Kind() == reflect.Ptr && s.Kind() == reflect.Ptr && !s.IsNil():
+			d.Set(reflect.New(d.Type().Elem()))
+			convert(d.Elem(), s.Elem())
+		}
+	}
+}
+
+// infinitySignature is the signature of the empty set of signers
+func infinitySignature() []byte {
+	sig := make([]byte, 96)
+	sig[0] = 0xc0
+	return sig
+}
+
+func repeat(v []byte, n int) [][]byte {
+	res := make([][]byte, n)
+	for i := range res {
+		res[i] = v
+	}
+	return res
+}
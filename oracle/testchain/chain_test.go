@@ -0,0 +1,129 @@
+package testchain
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle/beaconclient"
+	"oracle/crypto"
+	"oracle/forks"
+)
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	chain, err := NewChain(Config{
+		Participation: func(slot uint64) int { return 400 },
+		Missed:        func(slot uint64) bool { return slot == 5 || slot == 32 },
+	})
+	require.NoError(t, err)
+	slotsPerPeriod := chain.Spec.SlotsPerEpoch * chain.Spec.EpochsPerSyncCommitteePeriod
+	require.NoError(t, chain.AdvanceTo(slotsPerPeriod+10))
+	assert.EqualValues(t, slotsPerPeriod+10, chain.Head().Slot)
+
+	_, err = chain.GetBlock(ctx, "5")
+	assert.ErrorIs(t, err, beaconclient.NotFoundError)
+	_, err = chain.GetBlock(ctx, strconv.FormatUint(slotsPerPeriod+11, 10))
+	assert.ErrorIs(t, err, beaconclient.NotFoundError)
+
+	parent, err := chain.GetBlock(ctx, "genesis")
+	require.NoError(t, err)
+	for slot := uint64(1); slot <= slotsPerPeriod+10; slot++ {
+		state, err := chain.GetState(ctx, slot)
+		require.NoError(t, err)
+		stateRoot := crypto.MustHashTreeRoot(state.Raw)
+		if slot == 5 || slot == 32 {
+			assert.Equal(t, parent.Root().Bytes(), state.BlockRoots[slot-1])
+			continue
+		}
+		block, err := chain.GetBlock(ctx, strconv.FormatUint(slot, 10))
+		require.NoError(t, err)
+		assert.Equal(t, stateRoot, block.StateRoot)
+		assert.Equal(t, parent.Root(), block.ParentRoot)
+		assert.Equal(t, parent.ExecutionPayload.BlockNumber+1, block.ExecutionPayload.BlockNumber)
+
+		// sync committee of the block slot signs the parent block
+		bits := bitfield.Bitvector512(block.SyncAggregate.SyncCommitteeBits)
+		assert.EqualValues(t, 400, bits.Count())
+		var pk *crypto.G1Point
+		for _, i := range bits.BitIndices() {
+			key := crypto.MustDecodePKCompressed(state.CurrentSyncCommittee.Pubkeys[i])
+			pk = crypto.AddG1Points(pk, &key)
+		}
+		sig := crypto.MustDecodeSig(block.SyncAggregate.SyncCommitteeSignature)
+		assert.True(t, crypto.Verify(parent.Root(), chain.syncDomain(slot), *pk, sig), "slot %d", slot)
+		parent = block
+	}
+
+	state, err := chain.GetState(ctx, slotsPerPeriod+10)
+	require.NoError(t, err)
+	prevState, err := chain.GetState(ctx, slotsPerPeriod-1)
+	require.NoError(t, err)
+	assert.Equal(t, prevState.NextSyncCommittee, state.CurrentSyncCommittee)
+	assert.NotEqual(t, state.CurrentSyncCommittee.AggregatePubkey, state.NextSyncCommittee.AggregatePubkey)
+
+	epoch := (slotsPerPeriod + 10) / chain.Spec.SlotsPerEpoch
+	assert.Equal(t, epoch-2, state.FinalizedCheckpoint.Epoch)
+	finalized, err := chain.GetBlock(ctx, "finalized")
+	require.NoError(t, err)
+	assert.Equal(t, (epoch-2)*chain.Spec.SlotsPerEpoch, finalized.Slot)
+	assert.Equal(t, common.BytesToHash(state.FinalizedCheckpoint.Root), finalized.Root())
+
+	// header in the state is consistent with the payload of the head block
+	payload := chain.Head().Signed.(*forks.SignedBeaconBlockBellatrix).Message.Body.ExecutionPayload
+	header := state.Raw.(*forks.BeaconStateBellatrix).LatestExecutionPayloadHeader
+	assert.Equal(t, crypto.MustHashTreeRoot(payload), crypto.MustHashTreeRoot(header))
+}
+
+func TestChainCapella(t *testing.T) {
+	ctx := context.Background()
+	spec := DefaultSpec()
+	spec.CapellaForkVersion, spec.CapellaForkEpoch = "0x03000000", 2
+	chain, err := NewChain(Config{Spec: spec})
+	require.NoError(t, err)
+	require.NoError(t, chain.AdvanceTo(20))
+
+	for slot, version := range map[uint64]forks.Version{15: forks.Bellatrix, 16: forks.Capella, 20: forks.Capella} {
+		state, err := chain.GetState(ctx, slot)
+		require.NoError(t, err)
+		assert.Equal(t, version, state.Version, "slot %d", slot)
+		block, err := chain.GetBlock(ctx, strconv.FormatUint(slot, 10))
+		require.NoError(t, err)
+		assert.Equal(t, version, block.Version, "slot %d", slot)
+		assert.Equal(t, crypto.MustHashTreeRoot(state.Raw), block.StateRoot, "slot %d", slot)
+	}
+	state, err := chain.GetState(ctx, 16)
+	require.NoError(t, err)
+	assert.Equal(t, common.FromHex(spec.BellatrixForkVersion), state.Fork.PreviousVersion)
+	assert.Equal(t, common.FromHex(spec.CapellaForkVersion), state.Fork.CurrentVersion)
+
+	// the first block of the fork is signed with the previous fork version
+	assert.Equal(t, chain.syncDomain(15), chain.syncDomain(16))
+	assert.NotEqual(t, chain.syncDomain(16), chain.syncDomain(17))
+
+	head, err := chain.GetState(ctx, 20)
+	require.NoError(t, err)
+	payload := chain.Head().Signed.(*forks.SignedBeaconBlockCapella).Message.Body.ExecutionPayload
+	header := head.Raw.(*forks.BeaconStateCapella).LatestExecutionPayloadHeader
+	assert.Equal(t, crypto.MustHashTreeRoot(payload), crypto.MustHashTreeRoot(header))
+}
+
+func TestChainDeterministic(t *testing.T) {
+	a, err := NewChain(Config{Seed: 1})
+	require.NoError(t, err)
+	require.NoError(t, a.AdvanceTo(3))
+	b, err := NewChain(Config{Seed: 1})
+	require.NoError(t, err)
+	require.NoError(t, b.AdvanceTo(3))
+	assert.Equal(t, a.Head().Root(), b.Head().Root())
+
+	c, err := NewChain(Config{Seed: 2})
+	require.NoError(t, err)
+	require.NoError(t, c.AdvanceTo(3))
+	assert.NotEqual(t, a.Head().Root(), c.Head().Root())
+}
@@ -0,0 +1,144 @@
+package testchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"oracle/beaconclient"
+	"oracle/forks"
+)
+
+var _ beaconclient.Eth2Client = (*Chain)(nil)
+
+var errLightClientAPI = errors.New("light client API is not supported by the synthetic chain")
+
+func (c *Chain) GetSpec(ctx context.Context) (*beaconclient.ModelSpecData, error) {
+	return &beaconclient.ModelSpecData{
+		SecondsPerSlot:                 c.Spec.SecondsPerSlot,
+		SlotsPerEpoch:                  c.Spec.SlotsPerEpoch,
+		GenesisForkVersion:             c.Spec.GenesisForkVersion,
+		AltairForkEpoch:                c.Spec.AltairForkEpoch,
+		AltairForkVersion:              c.Spec.AltairForkVersion,
+		BellatrixForkEpoch:             c.Spec.BellatrixForkEpoch,
+		BellatrixForkVersion:           c.Spec.BellatrixForkVersion,
+		CapellaForkEpoch:               c.Spec.CapellaForkEpoch,
+		CapellaForkVersion:             c.Spec.CapellaForkVersion,
+		DenebForkEpoch:                 c.Spec.DenebForkEpoch,
+		DenebForkVersion:               c.Spec.DenebForkVersion,
+		ElectraForkEpoch:               c.Spec.ElectraForkEpoch,
+		ElectraForkVersion:             c.Spec.ElectraForkVersion,
+		FuluForkEpoch:                  c.Spec.FuluForkEpoch,
+		FuluForkVersion:                c.Spec.FuluForkVersion,
+		EpochsPerSyncCommitteePeriod:   c.Spec.EpochsPerSyncCommitteePeriod,
+		SyncCommitteeSize:              c.Spec.SyncCommitteeSize,
+		ValidatorRegistryLimit:         c.Spec.ValidatorRegistryLimit,
+		HistoricalRootsLimit:           c.Spec.HistoricalRootsLimit,
+		EpochsPerEth1VotingPeriod:      c.Spec.EpochsPerEth1VotingPeriod,
+		SlotsPerHistoricalRoot:         c.Spec.SlotsPerHistoricalRoot,
+		PendingDepositsLimit:           c.Spec.PendingDepositsLimit,
+		PendingPartialWithdrawalsLimit: c.Spec.PendingPartialWithdrawalsLimit,
+		PendingConsolidationsLimit:     c.Spec.PendingConsolidationsLimit,
+	}, nil
+}
+
+func (c *Chain) GetGenesis(ctx context.Context) (*beaconclient.ModelGenesisData, error) {
+	return &beaconclient.ModelGenesisData{
+		GenesisTime:           strconv.FormatInt(c.Genesis.GenesisTime.Unix(), 10),
+		GenesisValidatorsRoot: c.Genesis.GenesisValidatorsRoot.Hex(),
+	}, nil
+}
+
+func (c *Chain) GetBlock(ctx context.Context, id string) (*forks.BeaconBlock, error) {
+	root, err := c.GetBlockRoot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.blocks[root], nil
+}
+
+// GetBlockRoot supports the same block ids as the beacon API: head, genesis, finalized, slot or block root
+func (c *Chain) GetBlockRoot(ctx context.Context, id string) (common.Hash, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	switch id {
+	case "head":
+		return c.head, nil
+	case "genesis":
+		return c.slots[0], nil
+	case "finalized":
+		root := common.BytesToHash(c.states[len(c.states)-1].FinalizedCheckpoint.Root)
+		if root == (common.Hash{}) {
+			return c.slots[0], nil
+		}
+		return root, nil
+	}
+	if strings.HasPrefix(id, "0x") {
+		root := common.HexToHash(id)
+		if _, ok := c.blocks[root]; !ok {
+			return common.Hash{}, fmt.Errorf("block %s: %w", id, beaconclient.NotFoundError)
+		}
+		return root, nil
+	}
+	slot, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid block id %s: %w", id, beaconclient.BadRequestError)
+	}
+	if slot >= uint64(len(c.slots)) || c.slots[slot] == (common.Hash{}) {
+		return common.Hash{}, fmt.Errorf("block %d: %w", slot, beaconclient.NotFoundError)
+	}
+	return c.slots[slot], nil
+}
+
+// GetState returns the state at the given slot, states at the missed slots are available too
+func (c *Chain) GetState(ctx context.Context, slot uint64) (*forks.BeaconState, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if slot >= uint64(len(c.states)) {
+		return nil, fmt.Errorf("state %d: %w", slot, beaconclient.NotFoundError)
+	}
+	state := c.states[slot]
+	return forks.NewBeaconState(state.Version, state.Raw), nil
+}
+
+func (c *Chain) GetFinalityCheckpoints(ctx context.Context, slot uint64) (*beaconclient.ModelFinalityCheckpointsData, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if slot >= uint64(len(c.states)) {
+		return nil, fmt.Errorf("state %d: %w", slot, beaconclient.NotFoundError)
+	}
+	state := c.states[slot]
+	checkpoint := func(cp *forks.Checkpoint) beaconclient.ModelCheckpoint {
+		return beaconclient.ModelCheckpoint{Epoch: cp.Epoch, Root: common.BytesToHash(cp.Root)}
+	}
+	return &beaconclient.ModelFinalityCheckpointsData{
+		PreviousJustified: checkpoint(state.PreviousJustifiedCheckpoint),
+		CurrentJustified:  checkpoint(state.CurrentJustifiedCheckpoint),
+		Finalized:         checkpoint(state.FinalizedCheckpoint),
+	}, nil
+}
+
+func (c *Chain) GetLightClientBootstrap(ctx context.Context, blockRoot common.Hash) (*beaconclient.ModelLightClientBootstrapData, error) {
+	return nil, errLightClientAPI
+}
+
+func (c *Chain) GetLightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*beaconclient.ModelLightClientUpdateData, error) {
+	return nil, errLightClientAPI
+}
+
+func (c *Chain) GetLightClientFinalityUpdate(ctx context.Context) (*beaconclient.ModelLightClientUpdateData, error) {
+	return nil, errLightClientAPI
+}
+
+func (c *Chain) GetLightClientOptimisticUpdate(ctx context.Context) (*beaconclient.ModelLightClientUpdateData, error) {
+	return nil, errLightClientAPI
+}
@@ -0,0 +1,83 @@
+package testchain
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/crypto/bls/common"
+
+	"oracle/crypto"
+)
+
+// blsCurveOrder is the order r of the BLS12-381 subgroups, secret keys are scalars modulo r
+var blsCurveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+const domainSyncCommittee = 7
+
+// secretKey keeps the scalar along with the serialized public key,
+// so that keys of several signers can be summed up into a single aggregate key
+type secretKey struct {
+	scalar *big.Int
+	pubkey []byte
+}
+
+// deriveKeys generates n deterministic test keys from the seed, these keys must never be used outside of tests
+func deriveKeys(seed int64, n int) ([]*secretKey, error) {
+	keys := make([]*secretKey, n)
+	for i := range keys {
+		var buf [16]byte
+		binary.LittleEndian.PutUint64(buf[:8], uint64(seed))
+		binary.LittleEndian.PutUint64(buf[8:], uint64(i))
+		h := crypto.Sha256Hash([]byte("testchain key"), buf[:])
+		scalar := new(big.Int).SetBytes(h.Bytes())
+		scalar.Mod(scalar, new(big.Int).Sub(blsCurveOrder, big.NewInt(1)))
+		scalar.Add(scalar, big.NewInt(1))
+		sk, err := toBLSKey(scalar)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = &secretKey{scalar: scalar, pubkey: sk.PublicKey().Marshal()}
+	}
+	return keys, nil
+}
+
+// aggregateKey sums up the given secret keys, signature of the resulting key is equal to the aggregate signature
+// of all keys over the same message, and its public key is equal to the aggregate public key
+func aggregateKey(keys []*secretKey) *big.Int {
+	sum := new(big.Int)
+	for _, key := range keys {
+		sum.Add(sum, key.scalar)
+	}
+	return sum.Mod(sum, blsCurveOrder)
+}
+
+func toBLSKey(scalar *big.Int) (blscommon.SecretKey, error) {
+	var buf [32]byte
+	scalar.FillBytes(buf[:])
+	sk, err := blst.SecretKeyFromBytes(buf[:])
+	if err != nil {
+		return nil, fmt.Errorf("can't make secret key: %w", err)
+	}
+	return sk, nil
+}
+
+// sign signs the root with the given domain, as specified by compute_signing_root
+func sign(scalar *big.Int, root common.Hash, domain common.Hash) ([]byte, error) {
+	sk, err := toBLSKey(scalar)
+	if err != nil {
+		return nil, err
+	}
+	signingRoot := crypto.Sha256Hash(root.Bytes(), domain.Bytes())
+	return sk.Sign(signingRoot.Bytes()).Marshal(), nil
+}
+
+// computeDomain returns the signature domain of the given type for the fork version
+func computeDomain(domainType byte, forkVersion [4]byte, genesisValidatorsRoot common.Hash) common.Hash {
+	res := common.Hash{domainType, 0, 0, 0}
+	forkRoot := crypto.Sha256Hash(common.RightPadBytes(forkVersion[:], 32), genesisValidatorsRoot.Bytes())
+	copy(res[4:], forkRoot[:28])
+	return res
+}